@@ -54,6 +54,7 @@ var (
 	CloudShellAccountID             string
 	CosBackupPolicyID               string
 	CosCRN                          string
+	CosEventNotificationsTopicCRN   string
 	CosName                         string
 	CsRegion                        string
 	Customerpeerip                  string
@@ -717,6 +718,11 @@ func init() {
 		ActivityTrackerInstanceCRN = ""
 		fmt.Println("[WARN] Set the environment variable IBM_COS_ACTIVITY_TRACKER_CRN with a VALID ACTIVITY TRACKER INSTANCE CRN in valid region for testing ibm_cos_bucket* resources")
 	}
+	CosEventNotificationsTopicCRN = os.Getenv("IBM_COS_EVENT_NOTIFICATIONS_TOPIC_CRN")
+	if CosEventNotificationsTopicCRN == "" {
+		CosEventNotificationsTopicCRN = ""
+		fmt.Println("[WARN] Set the environment variable IBM_COS_EVENT_NOTIFICATIONS_TOPIC_CRN with a VALID EVENT NOTIFICATIONS TOPIC CRN for testing ibm_cos_bucket_notification_configuration resource")
+	}
 	MetricsMonitoringCRN = os.Getenv("IBM_COS_METRICS_MONITORING_CRN")
 	if MetricsMonitoringCRN == "" {
 		MetricsMonitoringCRN = ""
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const assumeProfileGrantType = "urn:ibm:params:oauth:grant-type:assume"
+
+// assumeProfileMinTokenLifetime is the lifetime in seconds assumed for a token when IAM returns
+// neither expires_in nor expiration, so the token is not requested again for every request.
+const assumeProfileMinTokenLifetime = 5 * 60
+
+// assumeProfileAuthenticator assumes a trusted profile, possibly in another account, with the
+// access token of any other authenticator. This lets a single identity (API key, compute
+// resource token...) assume a different trusted profile per provider alias.
+type assumeProfileAuthenticator struct {
+	// Source provides the access token of the calling identity.
+	Source core.Authenticator
+
+	// ProfileID or ProfileName and AccountID select the trusted profile to assume.
+	ProfileID   string
+	ProfileName string
+	AccountID   string
+
+	// URL is the IAM token server's base endpoint URL.
+	URL string
+
+	client *gohttp.Client
+
+	mutex       sync.Mutex
+	accessToken string
+	refreshTime int64
+}
+
+type assumeProfileTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Expiration  int64  `json:"expiration"`
+}
+
+type assumeProfileErrorResponse struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+func (a *assumeProfileAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_IAM_ASSUME
+}
+
+func (a *assumeProfileAuthenticator) Validate() error {
+	if a.Source == nil {
+		return fmt.Errorf("a source authenticator is required to assume a trusted profile")
+	}
+	if a.ProfileID == "" && a.ProfileName == "" {
+		return fmt.Errorf("one of id or name is required to assume a trusted profile")
+	}
+	if a.ProfileID != "" && a.ProfileName != "" {
+		return fmt.Errorf("only one of id or name can be set to assume a trusted profile")
+	}
+	if a.ProfileName != "" && a.AccountID == "" {
+		return fmt.Errorf("account_id is required to assume a trusted profile by name")
+	}
+	return a.Source.Validate()
+}
+
+func (a *assumeProfileAuthenticator) Authenticate(request *gohttp.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", bearerPrefix+token)
+	return nil
+}
+
+// GetToken returns the access token of the assumed trusted profile. The token is requested
+// again once 80% of its lifetime has passed.
+func (a *assumeProfileAuthenticator) GetToken() (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.accessToken != "" && time.Now().Unix() < a.refreshTime {
+		return a.accessToken, nil
+	}
+	tokenResponse, err := a.requestToken()
+	if err != nil {
+		return "", err
+	}
+	a.accessToken = tokenResponse.AccessToken
+	a.refreshTime = assumeProfileRefreshTime(time.Now().Unix(), tokenResponse)
+	return a.accessToken, nil
+}
+
+// assumeProfileRefreshTime returns the time at which 80% of the lifetime of the token has passed.
+// The lifetime is taken from expires_in, then from expiration, and is otherwise the minimum lifetime.
+func assumeProfileRefreshTime(now int64, tokenResponse *assumeProfileTokenResponse) int64 {
+	lifetime := tokenResponse.ExpiresIn
+	if lifetime <= 0 && tokenResponse.Expiration > now {
+		lifetime = tokenResponse.Expiration - now
+	}
+	if lifetime <= 0 {
+		lifetime = assumeProfileMinTokenLifetime
+	}
+	return now + lifetime*8/10
+}
+
+func (a *assumeProfileAuthenticator) requestToken() (*assumeProfileTokenResponse, error) {
+	sourceRequest, _ := gohttp.NewRequest(gohttp.MethodGet, a.URL, nil)
+	if err := a.Source.Authenticate(sourceRequest); err != nil {
+		return nil, fmt.Errorf("failed to authenticate the calling identity before assuming trusted profile %s: %w", a.profile(), err)
+	}
+	sourceToken := strings.TrimPrefix(sourceRequest.Header.Get("Authorization"), bearerPrefix)
+
+	form := url.Values{}
+	form.Set("grant_type", assumeProfileGrantType)
+	form.Set("access_token", sourceToken)
+	if a.ProfileID != "" {
+		form.Set("profile_id", a.ProfileID)
+	} else {
+		form.Set("profile_name", a.ProfileName)
+		form.Set("account", a.AccountID)
+	}
+	request, err := gohttp.NewRequest(gohttp.MethodPost, strings.TrimSuffix(a.URL, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	client := a.client
+	if client == nil {
+		client = &gohttp.Client{Transport: DefaultTransport(), Timeout: 30 * time.Second}
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assume trusted profile %s: %w", a.profile(), err)
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the IAM response for trusted profile %s: %w", a.profile(), err)
+	}
+
+	if response.StatusCode != gohttp.StatusOK {
+		var iamError assumeProfileErrorResponse
+		_ = json.Unmarshal(body, &iamError)
+		if response.StatusCode == gohttp.StatusBadRequest || response.StatusCode == gohttp.StatusUnauthorized || response.StatusCode == gohttp.StatusForbidden {
+			return nil, fmt.Errorf("IAM rejected the calling identity for trusted profile %s (%s: %s). Check that the trust policy of the trusted profile allows the calling identity and that the calling identity can assume it", a.profile(), iamError.ErrorCode, iamError.ErrorMessage)
+		}
+		return nil, fmt.Errorf("failed to assume trusted profile %s, IAM returned status %d: %s", a.profile(), response.StatusCode, string(body))
+	}
+	tokenResponse := &assumeProfileTokenResponse{}
+	if err := json.Unmarshal(body, tokenResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse the IAM response for trusted profile %s: %w", a.profile(), err)
+	}
+	return tokenResponse, nil
+}
+
+func (a *assumeProfileAuthenticator) profile() string {
+	if a.ProfileID != "" {
+		return a.ProfileID
+	}
+	return fmt.Sprintf("%s in account %s", a.ProfileName, a.AccountID)
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAssumeProfileAuthenticator(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		requests++
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.Form.Get("grant_type") != assumeProfileGrantType || r.Form.Get("access_token") != "source-token" {
+			t.Fatalf("bad token request: %v", r.Form)
+		}
+		if r.Form.Get("profile_name") != "deployer" || r.Form.Get("account") != "child" {
+			w.WriteHeader(gohttp.StatusBadRequest)
+			fmt.Fprint(w, `{"errorCode":"BXNIM0538E","errorMessage":"Not authorized to assume the profile."}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"assumed-token","expires_in":3600}`)
+	}))
+	defer server.Close()
+
+	source := &core.BearerTokenAuthenticator{BearerToken: "source-token"}
+	authenticator := &assumeProfileAuthenticator{Source: source, ProfileName: "deployer", AccountID: "child", URL: server.URL}
+	for i := 0; i < 2; i++ {
+		request, _ := gohttp.NewRequest(gohttp.MethodGet, server.URL, nil)
+		if err := authenticator.Authenticate(request); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if request.Header.Get("Authorization") != "Bearer assumed-token" {
+			t.Fatalf("bad Authorization header: %s", request.Header.Get("Authorization"))
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected the token to be cached, got %d requests", requests)
+	}
+
+	rejected := &assumeProfileAuthenticator{Source: source, ProfileID: "Profile-1", URL: server.URL}
+	if _, err := rejected.GetToken(); err == nil || !strings.Contains(err.Error(), "trust policy") {
+		t.Fatalf("expected a trust policy error, got %v", err)
+	}
+}
+
+func TestAssumeProfileAuthenticator_refresh(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		requests++
+		// Without expires_in and expiration the token is still cached
+		fmt.Fprintf(w, `{"access_token":"assumed-token-%d"}`, requests)
+	}))
+	defer server.Close()
+
+	source := &core.BearerTokenAuthenticator{BearerToken: "source-token"}
+	authenticator := &assumeProfileAuthenticator{Source: source, ProfileID: "Profile-1", URL: server.URL}
+	for i := 0; i < 2; i++ {
+		if token, err := authenticator.GetToken(); err != nil || token != "assumed-token-1" {
+			t.Fatalf("expected the cached token, got %q, %v", token, err)
+		}
+	}
+
+	// Once the refresh time has passed, a new token is requested
+	authenticator.refreshTime = time.Now().Unix() - 1
+	if token, err := authenticator.GetToken(); err != nil || token != "assumed-token-2" {
+		t.Fatalf("expected a new token, got %q, %v", token, err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 token requests, got %d", requests)
+	}
+}
+
+func TestAssumeProfileRefreshTime(t *testing.T) {
+	now := int64(1000000)
+	cases := []struct {
+		name     string
+		response assumeProfileTokenResponse
+		expected int64
+	}{
+		{"expires_in", assumeProfileTokenResponse{ExpiresIn: 3600, Expiration: now + 60}, now + 2880},
+		{"expiration", assumeProfileTokenResponse{Expiration: now + 1000}, now + 800},
+		{"expiration in the past", assumeProfileTokenResponse{Expiration: now - 10}, now + assumeProfileMinTokenLifetime*8/10},
+		{"no lifetime", assumeProfileTokenResponse{}, now + assumeProfileMinTokenLifetime*8/10},
+	}
+	for _, c := range cases {
+		if refreshTime := assumeProfileRefreshTime(now, &c.response); refreshTime != c.expected {
+			t.Errorf("%s: expected refresh time %d, got %d", c.name, c.expected, refreshTime)
+		}
+	}
+}
+
+func TestAssumeProfileAuthenticator_validate(t *testing.T) {
+	source := &core.BearerTokenAuthenticator{BearerToken: "source-token"}
+	authenticators := []*assumeProfileAuthenticator{
+		{Source: source},
+		{Source: source, ProfileID: "Profile-1", ProfileName: "deployer"},
+		{Source: source, ProfileName: "deployer"},
+		{ProfileID: "Profile-1"},
+	}
+	for _, a := range authenticators {
+		if err := a.Validate(); err == nil {
+			t.Fatalf("expected an error for %#v", a)
+		}
+	}
+}
@@ -149,6 +149,12 @@ const RetryAPIDelay = 5 * time.Second
 
 const IAMURL = iamidentity.DefaultServiceURL
 
+// Compute resource types accepted by iam_compute_resource_type
+const (
+	ComputeResourceTypeVPC       = "vpc"
+	ComputeResourceTypeContainer = "container"
+)
+
 const (
 	bearerPrefix    = "Bearer "
 	bearerPrefixLen = len(bearerPrefix)
@@ -226,6 +232,27 @@ type Config struct {
 	// IAM Refresh Token
 	IAMRefreshToken string
 
+	// IAMComputeResourceType selects compute resource token authentication: vpc or container
+	IAMComputeResourceType string
+
+	// IAMCRTokenFilename is the file holding the compute resource token for container authentication
+	IAMCRTokenFilename string
+
+	// AssumeProfileID or AssumeProfileName and AssumeProfileAccountID select a trusted profile
+	// assumed with the token of the configured identity
+	AssumeProfileID        string
+	AssumeProfileName      string
+	AssumeProfileAccountID string
+
+	// Profile is the named profile read from the shared credentials file
+	Profile string
+
+	// SharedCredentialsFile is the shared credentials file holding named profiles
+	SharedCredentialsFile string
+
+	// ConfigFile is the IBM Cloud CLI config.json to read the login session from
+	ConfigFile string
+
 	// Zone
 	Zone                string
 	Visibility          string
@@ -1487,6 +1514,20 @@ func (s *clientSession) Authenticator() (core.Authenticator, error) {
 
 // buildAuthenticator creates the appropriate authenticator based on configuration
 func (c *Config) buildAuthenticator(sess *Session, iamURL string) (core.Authenticator, error) {
+	authenticator, err := c.buildSourceAuthenticator(sess, iamURL)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrapAssumeProfile(authenticator, iamURL)
+}
+
+// buildSourceAuthenticator creates the authenticator of the configured identity
+func (c *Config) buildSourceAuthenticator(sess *Session, iamURL string) (core.Authenticator, error) {
+	// Priority 0: Trusted Profile Authentication (Compute Resource Token + Profile)
+	if c.IAMComputeResourceType != "" {
+		return c.buildComputeResourceAuthenticator(iamURL)
+	}
+
 	// Priority 1: Trusted Profile Authentication (API Key + Profile)
 	if c.BluemixAPIKey != "" && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
 		return c.buildTrustedProfileAuthenticator(iamURL)
@@ -1526,6 +1567,73 @@ func (c *Config) buildTrustedProfileAuthenticator(iamURL string) (core.Authentic
 	return authenticator, nil
 }
 
+// buildComputeResourceAuthenticator creates an authenticator that exchanges a compute resource
+// token for an IAM access token of the linked trusted profile. The token is read from the VPC
+// instance metadata service or from the projected service account token of an IKS/Code Engine pod.
+func (c *Config) buildComputeResourceAuthenticator(iamURL string) (core.Authenticator, error) {
+	switch c.IAMComputeResourceType {
+	case ComputeResourceTypeVPC:
+		if c.IAMTrustedProfileName != "" {
+			return nil, fmt.Errorf("IAM trusted profile name is not supported with the vpc compute resource type, use the trusted profile ID")
+		}
+		builder := core.NewVpcInstanceAuthenticatorBuilder()
+		if c.IAMTrustedProfileID != "" {
+			builder.SetIAMProfileID(c.IAMTrustedProfileID)
+		}
+		authenticator, err := builder.Build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build VPC instance authenticator: %w", err)
+		}
+		return authenticator, nil
+	case ComputeResourceTypeContainer:
+		if c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "" {
+			return nil, fmt.Errorf("IAM trusted profile ID or name is required with the container compute resource type")
+		}
+		builder := core.NewContainerAuthenticatorBuilder().
+			SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL))
+		if c.IAMTrustedProfileID != "" {
+			builder.SetIAMProfileID(c.IAMTrustedProfileID)
+		} else {
+			builder.SetIAMProfileName(c.IAMTrustedProfileName)
+		}
+		if c.IAMCRTokenFilename != "" {
+			builder.SetCRTokenFilename(c.IAMCRTokenFilename)
+		}
+		authenticator, err := builder.Build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build container authenticator: %w", err)
+		}
+		return authenticator, nil
+	}
+	return nil, fmt.Errorf("unsupported IAM compute resource type %q, must be one of %s or %s", c.IAMComputeResourceType, ComputeResourceTypeVPC, ComputeResourceTypeContainer)
+}
+
+// authenticatesWithAPIKey reports whether the API key itself is the identity of the clients, so
+// clients that manage their own IAM tokens can be given the API key instead of an access token.
+func (c *Config) authenticatesWithAPIKey() bool {
+	return c.BluemixAPIKey != "" && c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "" &&
+		c.IAMComputeResourceType == "" && c.AssumeProfileID == "" && c.AssumeProfileName == ""
+}
+
+// wrapAssumeProfile assumes the trusted profile of the assume_profile block with the token of
+// the given authenticator. The authenticator is returned unchanged when no profile is configured.
+func (c *Config) wrapAssumeProfile(source core.Authenticator, iamURL string) (core.Authenticator, error) {
+	if c.AssumeProfileID == "" && c.AssumeProfileName == "" {
+		return source, nil
+	}
+	authenticator := &assumeProfileAuthenticator{
+		Source:      source,
+		ProfileID:   c.AssumeProfileID,
+		ProfileName: c.AssumeProfileName,
+		AccountID:   c.AssumeProfileAccountID,
+		URL:         EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to build assume profile authenticator: %w", err)
+	}
+	return authenticator, nil
+}
+
 // buildIAMAuthenticator creates an IAM authenticator using API key or refresh token
 func (c *Config) buildIAMAuthenticator(sess *Session, iamURL string) (core.Authenticator, error) {
 	url := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)
@@ -1570,6 +1678,9 @@ func (c *Config) buildBearerTokenAuthenticator(sess *Session) (core.Authenticato
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (*clientSession, error) {
+	if err := c.loadSharedConfig(); err != nil {
+		return nil, err
+	}
 	sess, fileMap, err := newSession(c)
 	if err != nil {
 		return nil, err
@@ -1750,7 +1861,7 @@ func (c *Config) ClientSession() (*clientSession, error) {
 		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 	}
 	var options kp.ClientConfig
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
+	if c.authenticatesWithAPIKey() {
 		options = kp.ClientConfig{
 			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
@@ -1793,7 +1904,7 @@ func (c *Config) ClientSession() (*clientSession, error) {
 		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
 	}
 	var kmsOptions kp.ClientConfig
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
+	if c.authenticatesWithAPIKey() {
 		kmsOptions = kp.ClientConfig{
 			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
@@ -4149,7 +4260,13 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
 	}
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
+	if c.IAMComputeResourceType != "" {
+		log.Printf("Configuring Session with %s compute resource token", c.IAMComputeResourceType)
+		authenticator, err = c.buildComputeResourceAuthenticator(iamURL)
+		if err != nil {
+			return nil, fileMap, err
+		}
+	} else if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
 		if c.IAMTrustedProfileID != "" {
 			log.Println("Configuring Session with Trusted Profile ID")
 			authenticator, err = core.NewIamAssumeAuthenticatorBuilder().
@@ -4203,6 +4320,11 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 		}
 	}
 
+	authenticator, err = c.wrapAssumeProfile(authenticator, iamURL)
+	if err != nil {
+		return nil, fileMap, err
+	}
+
 	var sess *bxsession.Session
 	bmxConfig := &bluemix.Config{
 		IAMAccessToken:  c.IAMToken,
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+// DefaultSharedCredentialsFile is the shared credentials file read when a profile is selected
+// without shared_credentials_file.
+const DefaultSharedCredentialsFile = "~/.ibmcloud/credentials"
+
+// cliConfig is the subset of the IBM Cloud CLI config.json used by the provider.
+type cliConfig struct {
+	IAMToken        string `json:"IAMToken"`
+	IAMRefreshToken string `json:"IAMRefreshToken"`
+	Region          string `json:"Region"`
+	Account         struct {
+		GUID string `json:"GUID"`
+	} `json:"Account"`
+	ResourceGroup struct {
+		GUID string `json:"GUID"`
+	} `json:"ResourceGroup"`
+}
+
+// hasCredentials reports whether any IAM credential was configured through provider arguments
+// or environment variables.
+func (c *Config) hasCredentials() bool {
+	return c.BluemixAPIKey != "" || c.IAMToken != "" || c.IAMRefreshToken != "" ||
+		c.IAMComputeResourceType != ""
+}
+
+// loadSharedConfig fills the settings that were not configured through provider arguments or
+// environment variables from the named profile of the shared credentials file and then from
+// the IBM Cloud CLI config.json. Credentials are only taken from a single source.
+func (c *Config) loadSharedConfig() error {
+	if c.Profile != "" {
+		if err := c.loadSharedCredentialsProfile(); err != nil {
+			return err
+		}
+	}
+	if c.ConfigFile != "" {
+		if err := c.loadCLIConfig(); err != nil {
+			return err
+		}
+	}
+	if c.Region == "" {
+		c.Region = "us-south"
+	}
+	return nil
+}
+
+func (c *Config) loadSharedCredentialsProfile() error {
+	path := c.SharedCredentialsFile
+	if path == "" {
+		path = DefaultSharedCredentialsFile
+	}
+	path, err := expandHomeDir(path)
+	if err != nil {
+		return err
+	}
+	credentials, err := ini.Load(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the shared credentials file %s: %s", path, err)
+	}
+	section, err := credentials.GetSection(c.Profile)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Profile %s not found in the shared credentials file %s", c.Profile, path)
+	}
+	log.Printf("[INFO] Configuring provider from profile %s of %s", c.Profile, path)
+
+	if !c.hasCredentials() {
+		c.BluemixAPIKey = section.Key("ibmcloud_api_key").String()
+		if c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "" {
+			c.IAMTrustedProfileID = section.Key("iam_profile_id").String()
+			c.IAMTrustedProfileName = section.Key("iam_profile_name").String()
+		}
+	}
+	if c.SoftLayerUserName == "" && c.SoftLayerAPIKey == "" {
+		c.SoftLayerUserName = section.Key("iaas_classic_username").String()
+		c.SoftLayerAPIKey = section.Key("iaas_classic_api_key").String()
+	}
+	setIfEmpty(&c.Account, section.Key("ibmcloud_account_id").String())
+	setIfEmpty(&c.Region, section.Key("region").String())
+	setIfEmpty(&c.ResourceGroup, section.Key("resource_group").String())
+	return nil
+}
+
+func (c *Config) loadCLIConfig() error {
+	path, err := expandHomeDir(c.ConfigFile)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the IBM Cloud CLI configuration %s: %s", path, err)
+	}
+	var cli cliConfig
+	if err := json.Unmarshal(data, &cli); err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the IBM Cloud CLI configuration %s: %s", path, err)
+	}
+	log.Printf("[INFO] Configuring provider from the IBM Cloud CLI configuration %s", path)
+
+	// The CLI access token expires after an hour. Passing the refresh token makes every client
+	// authenticate with it, so the access token is renewed for the whole run.
+	if !c.hasCredentials() {
+		if cli.IAMToken == "" && cli.IAMRefreshToken == "" {
+			return fmt.Errorf("[ERROR] The IBM Cloud CLI configuration %s has no IAM token, log in with ibmcloud login", path)
+		}
+		c.IAMToken = cli.IAMToken
+		c.IAMRefreshToken = cli.IAMRefreshToken
+	}
+	setIfEmpty(&c.Account, cli.Account.GUID)
+	setIfEmpty(&c.Region, cli.Region)
+	setIfEmpty(&c.ResourceGroup, cli.ResourceGroup.GUID)
+	return nil
+}
+
+func setIfEmpty(target *string, value string) {
+	if *target == "" {
+		*target = value
+	}
+}
+
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error resolving the home directory for %s: %s", path, err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
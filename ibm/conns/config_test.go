@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestBuildComputeResourceAuthenticator(t *testing.T) {
+	c := &Config{IAMComputeResourceType: ComputeResourceTypeVPC, IAMTrustedProfileID: "Profile-1"}
+	authenticator, err := c.buildComputeResourceAuthenticator(IAMURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if authenticator.AuthenticationType() != core.AUTHTYPE_VPC {
+		t.Fatalf("bad authentication type: %s", authenticator.AuthenticationType())
+	}
+
+	c = &Config{IAMComputeResourceType: ComputeResourceTypeContainer, IAMTrustedProfileName: "profile", IAMCRTokenFilename: "/tmp/cr-token"}
+	authenticator, err = c.buildComputeResourceAuthenticator(IAMURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	container, ok := authenticator.(*core.ContainerAuthenticator)
+	if !ok {
+		t.Fatalf("bad authenticator: %#v", authenticator)
+	}
+	if container.CRTokenFilename != "/tmp/cr-token" || container.IAMProfileName != "profile" {
+		t.Fatalf("bad container authenticator: %#v", container)
+	}
+}
+
+func TestBuildComputeResourceAuthenticator_invalid(t *testing.T) {
+	configs := []*Config{
+		{IAMComputeResourceType: ComputeResourceTypeVPC, IAMTrustedProfileName: "profile"},
+		{IAMComputeResourceType: ComputeResourceTypeContainer},
+		{IAMComputeResourceType: "vsi", IAMTrustedProfileID: "Profile-1"},
+	}
+	for _, c := range configs {
+		if _, err := c.buildComputeResourceAuthenticator(IAMURL); err == nil {
+			t.Fatalf("expected an error for %#v", c)
+		}
+	}
+}
+
+func TestLoadSharedConfig(t *testing.T) {
+	dir := t.TempDir()
+	credentialsFile := filepath.Join(dir, "credentials")
+	credentials := "[default]\nibmcloud_api_key = default-key\n\n[dev]\nibmcloud_api_key = dev-key\nregion = eu-de\nresource_group = dev-group\n"
+	if err := os.WriteFile(credentialsFile, []byte(credentials), 0600); err != nil {
+		t.Fatal(err)
+	}
+	configFile := filepath.Join(dir, "config.json")
+	cli := `{"IAMToken": "Bearer access", "IAMRefreshToken": "refresh", "Region": "us-east", "Account": {"GUID": "account"}}`
+	if err := os.WriteFile(configFile, []byte(cli), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{Profile: "dev", SharedCredentialsFile: credentialsFile, ConfigFile: configFile}
+	if err := c.loadSharedConfig(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if c.BluemixAPIKey != "dev-key" || c.IAMRefreshToken != "" || c.Region != "eu-de" || c.ResourceGroup != "dev-group" || c.Account != "account" {
+		t.Fatalf("bad config from profile: %#v", c)
+	}
+
+	c = &Config{ConfigFile: configFile, Region: "jp-tok"}
+	if err := c.loadSharedConfig(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if c.IAMToken != "Bearer access" || c.IAMRefreshToken != "refresh" || c.Region != "jp-tok" {
+		t.Fatalf("bad config from CLI configuration: %#v", c)
+	}
+
+	c = &Config{Profile: "missing", SharedCredentialsFile: credentialsFile}
+	if err := c.loadSharedConfig(); err == nil {
+		t.Fatal("expected an error for a missing profile")
+	}
+}
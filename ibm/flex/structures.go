@@ -1317,6 +1317,26 @@ func RoutingRuleRedirectGet(in *s3.Redirect) []map[string]interface{} {
 	return redirect
 }
 
+func CorsRulesGet(in []*s3.CORSRule) []map[string]interface{} {
+	corsRules := make([]map[string]interface{}, 0, len(in))
+	for _, corsRule := range in {
+		if corsRule == nil {
+			continue
+		}
+		corsRuleConfig := map[string]interface{}{
+			"allowed_headers": aws.StringValueSlice(corsRule.AllowedHeaders),
+			"allowed_methods": aws.StringValueSlice(corsRule.AllowedMethods),
+			"allowed_origins": aws.StringValueSlice(corsRule.AllowedOrigins),
+			"expose_headers":  aws.StringValueSlice(corsRule.ExposeHeaders),
+		}
+		if corsRule.MaxAgeSeconds != nil {
+			corsRuleConfig["max_age_seconds"] = int(aws.Int64Value(corsRule.MaxAgeSeconds))
+		}
+		corsRules = append(corsRules, corsRuleConfig)
+	}
+	return corsRules
+}
+
 func FlattenLimits(in *whisk.Limits) []interface{} {
 	att := make(map[string]interface{})
 	if in.Timeout != nil {
@@ -210,6 +210,57 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "IAM Authentication refresh token",
 			},
+			"iam_compute_resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{conns.ComputeResourceTypeVPC, conns.ComputeResourceTypeContainer}),
+				Description:  "Compute resource to read the IAM compute resource token from to authenticate as the trusted profile: vpc or container.",
+			},
+			"iam_cr_token_filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the file that contains the compute resource token when iam_compute_resource_type is container.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the profile in the shared credentials file to read the provider configuration from.",
+			},
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the shared credentials file that contains the named profiles. Default is ~/.ibmcloud/credentials.",
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the IBM Cloud CLI config.json to read the login session from, for example ~/.bluemix/config.json.",
+			},
+			"assume_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Assume an IAM trusted profile, possibly in another account, with the token of the configured identity.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the IAM trusted profile to assume.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the IAM trusted profile to assume. Requires account_id.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the account of the IAM trusted profile to assume.",
+						},
+					},
+				},
+			},
 			"visibility": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -342,6 +393,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_ruleset_versions":                      cis.DataSourceIBMCISRulesetVersions(),
 			"ibm_cis_ruleset_rules_by_tag":                  cis.DataSourceIBMCISRulesetRulesByTag(),
 			"ibm_cis_ruleset_entrypoint_versions":           cis.DataSourceIBMCISRulesetEntrypointVersions(),
+			"ibm_cis_ruleset_export":                        cis.DataSourceIBMCISRulesetExport(),
 			"ibm_cis_webhooks":                              cis.DataSourceIBMCISWebhooks(),
 			"ibm_cis_logpush_jobs":                          cis.DataSourceIBMCISLogPushJobs(),
 			"ibm_cis_edge_functions_actions":                cis.DataSourceIBMCISEdgeFunctionsActions(),
@@ -426,6 +478,7 @@ func Provider() *schema.Provider {
 			"ibm_hpcs_vault":                                hpcs.DataSourceIbmVault(),
 			"ibm_iam_access_group":                          iamaccessgroup.DataSourceIBMIAMAccessGroup(),
 			"ibm_iam_access_group_policy":                   iampolicy.DataSourceIBMIAMAccessGroupPolicy(),
+			"ibm_iam_access_check":                          iampolicy.DataSourceIBMIAMAccessCheck(),
 			"ibm_iam_access_group_template_versions":        iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateVersions(),
 			"ibm_iam_access_group_template_assignment":      iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateAssignment(),
 			"ibm_iam_account_settings":                      iamidentity.DataSourceIBMIamAccountSettings(),
@@ -697,6 +750,8 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
+			"ibm_kms_key_versions":                   kms.DataSourceIBMKMSKeyVersions(),
+			"ibm_kms_key_registrations":              kms.DataSourceIBMKMSKeyRegistrations(),
 			"ibm_kms_kmip_adapter":                   kms.DataSourceIBMKMSKmipAdapter(),
 			"ibm_kms_kmip_adapters":                  kms.DataSourceIBMKMSKmipAdapters(),
 			"ibm_kms_kmip_client_cert":               kms.DataSourceIBMKmsKMIPClientCertificate(),
@@ -854,6 +909,7 @@ func Provider() *schema.Provider {
 			"ibm_dl_offering_speeds":       directlink.DataSourceIBMDLOfferingSpeeds(),
 			"ibm_dl_port":                  directlink.DataSourceIBMDirectLinkPort(),
 			"ibm_dl_ports":                 directlink.DataSourceIBMDirectLinkPorts(),
+			"ibm_dl_gateway_health":        directlink.DataSourceIBMDLGatewayHealth(),
 			"ibm_dl_gateway":               directlink.DataSourceIBMDLGateway(),
 			"ibm_dl_locations":             directlink.DataSourceIBMDLLocations(),
 			"ibm_dl_routers":               directlink.DataSourceIBMDLRouters(),
@@ -877,6 +933,7 @@ func Provider() *schema.Provider {
 			"ibm_tg_locations":                 transitgateway.DataSourceIBMTransitGatewaysLocations(),
 			"ibm_tg_location":                  transitgateway.DataSourceIBMTransitGatewaysLocation(),
 			"ibm_tg_route_report":              transitgateway.DataSourceIBMTransitGatewayRouteReport(),
+			"ibm_tg_route_simulation":          transitgateway.DataSourceIBMTransitGatewayRouteSimulation(),
 			"ibm_tg_route_reports":             transitgateway.DataSourceIBMTransitGatewayRouteReports(),
 
 			// Added for BSS Enterprise
@@ -1266,6 +1323,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_dns_record":                      cis.ResourceIBMCISDnsRecord(),
 			"ibm_cis_dns_records_import":              cis.ResourceIBMCISDNSRecordsImport(),
 			"ibm_cis_dns_records_batch":               cis.ResourceIBMCISDNSRecordsBatch(),
+			"ibm_cis_dns_zone_records":                cis.ResourceIBMCISDNSZoneRecords(),
 			"ibm_cis_rate_limit":                      cis.ResourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                       cis.ResourceIBMCISPageRule(),
 			"ibm_cis_edge_functions_action":           cis.ResourceIBMCISEdgeFunctionsAction(),
@@ -1339,9 +1397,12 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket":                                cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":               cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                         cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects_sync":                   cos.ResourceIBMCOSBucketObjectsSync(),
 			"ibm_cos_bucket_object_lock_configuration":      cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":          cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_cos_bucket_lifecycle_configuration":        cos.ResourceIBMCOSBucketLifecycleConfiguration(),
+			"ibm_cos_bucket_cors_configuration":             cos.ResourceIBMCOSBucketCorsConfiguration(),
+			"ibm_cos_bucket_notification_configuration":     cos.ResourceIBMCOSBucketNotificationConfiguration(),
 			"ibm_cos_backup_vault":                          cos.ResourceIBMCOSBackupVault(),
 			"ibm_cos_backup_policy":                         cos.ResourceIBMCOSBackupPolicy(),
 			"ibm_dns_domain":                                classicinfrastructure.ResourceIBMDNSDomain(),
@@ -1370,6 +1431,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_access_group_dynamic_rule":             iamaccessgroup.ResourceIBMIAMDynamicRule(),
 			"ibm_iam_access_group_members":                  iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
 			"ibm_iam_access_group_policy":                   iampolicy.ResourceIBMIAMAccessGroupPolicy(),
+			"ibm_iam_access_group_policies":                 iampolicy.ResourceIBMIAMAccessGroupPolicies(),
 			"ibm_iam_authorization_policy":                  iampolicy.ResourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":           iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
 			"ibm_iam_user_policy":                           iampolicy.ResourceIBMIAMUserPolicy(),
@@ -1377,6 +1439,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_id":                            iamidentity.ResourceIBMIamServiceID(),
 			"ibm_iam_serviceid_group":                       iamidentity.ResourceIBMIamServiceidGroup(),
 			"ibm_iam_service_api_key":                       iamidentity.ResourceIBMIAMServiceAPIKey(),
+			"ibm_iam_service_api_key_rotation":              iamidentity.ResourceIBMIAMServiceAPIKeyRotation(),
 			"ibm_iam_service_policy":                        iampolicy.ResourceIBMIAMServicePolicy(),
 			"ibm_iam_user_invite":                           iampolicy.ResourceIBMIAMUserInvite(),
 			"ibm_iam_api_key":                               iamidentity.ResourceIBMIAMApiKey(),
@@ -1622,35 +1685,39 @@ func Provider() *schema.Provider {
 			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
 
 			// Private DNS related resources
-			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
-			"ibm_dns_permitted_network": dnsservices.ResourceIBMPrivateDNSPermittedNetwork(),
-			"ibm_dns_resource_record":   dnsservices.ResourceIBMPrivateDNSResourceRecord(),
-			"ibm_dns_glb_monitor":       dnsservices.ResourceIBMPrivateDNSGLBMonitor(),
-			"ibm_dns_glb_pool":          dnsservices.ResourceIBMPrivateDNSGLBPool(),
-			"ibm_dns_glb":               dnsservices.ResourceIBMPrivateDNSGLB(),
+			"ibm_dns_zone":                 dnsservices.ResourceIBMPrivateDNSZone(),
+			"ibm_dns_permitted_network":    dnsservices.ResourceIBMPrivateDNSPermittedNetwork(),
+			"ibm_dns_resource_record":      dnsservices.ResourceIBMPrivateDNSResourceRecord(),
+			"ibm_dns_split_horizon_record": dnsservices.ResourceIBMDNSSplitHorizonRecord(),
+			"ibm_dns_glb_monitor":          dnsservices.ResourceIBMPrivateDNSGLBMonitor(),
+			"ibm_dns_glb_pool":             dnsservices.ResourceIBMPrivateDNSGLBPool(),
+			"ibm_dns_glb":                  dnsservices.ResourceIBMPrivateDNSGLB(),
 
 			// Added for Custom Resolver
-			"ibm_dns_custom_resolver":                 dnsservices.ResourceIBMPrivateDNSCustomResolver(),
-			"ibm_dns_custom_resolver_forwarding_rule": dnsservices.ResourceIBMPrivateDNSForwardingRule(),
-			"ibm_dns_custom_resolver_secondary_zone":  dnsservices.ResourceIBMPrivateDNSSecondaryZone(),
-			"ibm_dns_linked_zone":                     dnsservices.ResourceIBMDNSLinkedZone(),
+			"ibm_dns_custom_resolver":                  dnsservices.ResourceIBMPrivateDNSCustomResolver(),
+			"ibm_dns_custom_resolver_forwarding_rule":  dnsservices.ResourceIBMPrivateDNSForwardingRule(),
+			"ibm_dns_custom_resolver_forwarding_rules": dnsservices.ResourceIBMPrivateDNSForwardingRules(),
+			"ibm_dns_custom_resolver_secondary_zone":   dnsservices.ResourceIBMPrivateDNSSecondaryZone(),
+			"ibm_dns_linked_zone":                      dnsservices.ResourceIBMDNSLinkedZone(),
 
 			// Direct Link related resources
-			"ibm_dl_gateway":               directlink.ResourceIBMDLGateway(),
-			"ibm_dl_virtual_connection":    directlink.ResourceIBMDLGatewayVC(),
-			"ibm_dl_provider_gateway":      directlink.ResourceIBMDLProviderGateway(),
-			"ibm_dl_route_report":          directlink.ResourceIBMDLGatewayRouteReport(),
-			"ibm_dl_gateway_action":        directlink.ResourceIBMDLGatewayAction(),
-			"ibm_dl_gateway_macsec_config": directlink.ResourceIBMDLGatewayMacsecConfig(),
-			"ibm_dl_gateway_macsec_cak":    directlink.ResourceIBMDLGatewayMacsecCak(),
+			"ibm_dl_gateway":                             directlink.ResourceIBMDLGateway(),
+			"ibm_dl_virtual_connection":                  directlink.ResourceIBMDLGatewayVC(),
+			"ibm_dl_virtual_connection_request_approval": directlink.ResourceIBMDLVirtualConnectionRequestApproval(),
+			"ibm_dl_provider_gateway":                    directlink.ResourceIBMDLProviderGateway(),
+			"ibm_dl_route_report":                        directlink.ResourceIBMDLGatewayRouteReport(),
+			"ibm_dl_gateway_action":                      directlink.ResourceIBMDLGatewayAction(),
+			"ibm_dl_gateway_macsec_config":               directlink.ResourceIBMDLGatewayMacsecConfig(),
+			"ibm_dl_gateway_macsec_cak":                  directlink.ResourceIBMDLGatewayMacsecCak(),
 
 			// Added for Transit Gateway
-			"ibm_tg_gateway":                  transitgateway.ResourceIBMTransitGateway(),
-			"ibm_tg_connection":               transitgateway.ResourceIBMTransitGatewayConnection(),
-			"ibm_tg_connection_action":        transitgateway.ResourceIBMTransitGatewayConnectionAction(),
-			"ibm_tg_connection_prefix_filter": transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilter(),
-			"ibm_tg_route_report":             transitgateway.ResourceIBMTransitGatewayRouteReport(),
-			"ibm_tg_connection_rgre_tunnel":   transitgateway.ResourceIBMTransitGatewayConnectionRgreTunnel(),
+			"ibm_tg_gateway":                     transitgateway.ResourceIBMTransitGateway(),
+			"ibm_tg_connection":                  transitgateway.ResourceIBMTransitGatewayConnection(),
+			"ibm_tg_connection_action":           transitgateway.ResourceIBMTransitGatewayConnectionAction(),
+			"ibm_tg_connection_request_approval": transitgateway.ResourceIBMTransitGatewayConnectionRequestApproval(),
+			"ibm_tg_connection_prefix_filter":    transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilter(),
+			"ibm_tg_route_report":                transitgateway.ResourceIBMTransitGatewayRouteReport(),
+			"ibm_tg_connection_rgre_tunnel":      transitgateway.ResourceIBMTransitGatewayConnectionRgreTunnel(),
 
 			// Catalog related resources
 			"ibm_cm_offering_instance": catalogmanagement.ResourceIBMCmOfferingInstance(),
@@ -1692,6 +1759,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_username_password_secret":                                    secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmUsernamePasswordSecret()),
 			"ibm_sm_custom_credentials_secret":                                   secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmCustomCredentialsSecret()),
 			"ibm_sm_kv_secret":                                                   secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmKvSecret()),
+			"ibm_sm_secret_sync":                                                 secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretSync()),
 			"ibm_sm_public_certificate_configuration_ca_lets_encrypt":            secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt()),
 			"ibm_sm_public_certificate_configuration_dns_cis":                    secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmConfigurationPublicCertificateDNSCis()),
 			"ibm_sm_public_certificate_configuration_dns_classic_infrastructure": secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructure()),
@@ -2125,6 +2193,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_dns_record":                             cis.ResourceIBMCISDnsRecordValidator(),
 				"ibm_cis_dns_records_import":                     cis.ResourceIBMCISDnsRecordsImportValidator(),
 				"ibm_cis_dns_records_batch":                      cis.ResourceIBMCISDNSRecordsBatchValidator(),
+				"ibm_cis_dns_zone_records":                       cis.ResourceIBMCISDNSZoneRecordsValidator(),
 				"ibm_cis_edge_functions_action":                  cis.ResourceIBMCISEdgeFunctionsActionValidator(),
 				"ibm_cis_edge_functions_trigger":                 cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
 				"ibm_cis_global_load_balancer":                   cis.ResourceIBMCISGlbValidator(),
@@ -2153,9 +2222,11 @@ func Validator() validate.ValidatorDict {
 				"ibm_app_config_feature":                         appconfiguration.ResourceIBMAppConfigFeatureValidator(),
 				"ibm_tg_connection":                              transitgateway.ResourceIBMTransitGatewayConnectionValidator(),
 				"ibm_tg_connection_action":                       transitgateway.ResourceIBMTransitGatewayConnectionActionValidator(),
+				"ibm_tg_connection_request_approval":             transitgateway.ResourceIBMTransitGatewayConnectionRequestApprovalValidator(),
 				"ibm_tg_connection_prefix_filter":                transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilterValidator(),
 				"ibm_tg_connection_rgre_tunnel":                  transitgateway.ResourceIBMTransitGatewayConnectionRgreTunnelValidator(),
 				"ibm_dl_virtual_connection":                      directlink.ResourceIBMDLGatewayVCValidator(),
+				"ibm_dl_virtual_connection_request_approval":     directlink.ResourceIBMDLVirtualConnectionRequestApprovalValidator(),
 				"ibm_dl_gateway":                                 directlink.ResourceIBMDLGatewayValidator(),
 				"ibm_dl_provider_gateway":                        directlink.ResourceIBMDLProviderGatewayValidator(),
 				"ibm_dl_gateway_action":                          directlink.ResourceIBMDLGatewayActionValidator(),
@@ -2267,6 +2338,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_dns_glb_monitor":                                dnsservices.ResourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_custom_resolver":                            dnsservices.ResourceIBMPrivateDNSCustomResolverValidator(),
 				"ibm_dns_custom_resolver_forwarding_rule":            dnsservices.ResourceIBMPrivateDNSForwardingRuleValidator(),
+				"ibm_dns_custom_resolver_forwarding_rules":           dnsservices.ResourceIBMPrivateDNSForwardingRulesValidator(),
 				"ibm_schematics_action":                              schematics.ResourceIBMSchematicsActionValidator(),
 				"ibm_schematics_job":                                 schematics.ResourceIBMSchematicsJobValidator(),
 				"ibm_schematics_workspace":                           schematics.ResourceIBMSchematicsWorkspaceValidator(),
@@ -2367,10 +2439,12 @@ func Validator() validate.ValidatorDict {
 				"ibm_iam_trusted_profile_claim_rule":       iamidentity.ResourceIBMIAMTrustedProfileClaimRuleValidator(),
 				"ibm_iam_trusted_profile_link":             iamidentity.ResourceIBMIAMTrustedProfileLinkValidator(),
 				"ibm_iam_service_api_key":                  iamidentity.ResourceIBMIAMServiceAPIKeyValidator(),
+				"ibm_iam_service_api_key_rotation":         iamidentity.ResourceIBMIAMServiceAPIKeyRotationValidator(),
 				"ibm_iam_trusted_profile_identity":         iamidentity.ResourceIBMIamTrustedProfileIdentityValidator(),
 
 				"ibm_iam_trusted_profile_policy":  iampolicy.ResourceIBMIAMTrustedProfilePolicyValidator(),
 				"ibm_iam_access_group_policy":     iampolicy.ResourceIBMIAMAccessGroupPolicyValidator(),
+				"ibm_iam_access_group_policies":   iampolicy.ResourceIBMIAMAccessGroupPoliciesValidator(),
 				"ibm_iam_service_policy":          iampolicy.ResourceIBMIAMServicePolicyValidator(),
 				"ibm_iam_authorization_policy":    iampolicy.ResourceIBMIAMAuthorizationPolicyValidator(),
 				"ibm_iam_policy_template":         iampolicy.ResourceIBMIAMPolicyTemplateValidator(),
@@ -2487,6 +2561,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_ruleset_versions":            cis.DataSourceIBMCISRulesetVersionsValidator(),
 				"ibm_cis_ruleset_rules_by_tag":        cis.DataSourceIBMCISRulesetRulesByTagValidator(),
 				"ibm_cis_ruleset_entrypoint_versions": cis.DataSourceIBMCISRulesetEntrypointVersionsValidator(),
+				"ibm_cis_ruleset_export":              cis.DataSourceIBMCISRulesetExportValidator(),
 				"ibm_cis_waf_groups":                  cis.DataSourceIBMCISWAFGroupsValidator(),
 				"ibm_cis_waf_packages":                cis.DataSourceIBMCISWAFPackagesValidator(),
 				"ibm_cis_waf_rules":                   cis.DataSourceIBMCISWAFRulesValidator(),
@@ -2543,6 +2618,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId, iamTrustedProfileName, account string
+	var iamComputeResourceType, iamCRTokenFilename string
+	var profile, sharedCredentialsFile, configFile string
+	var assumeProfileID, assumeProfileName, assumeProfileAccountID string
 	if key, ok := d.GetOk("bluemix_api_key"); ok {
 		bluemixAPIKey = key.(string)
 	}
@@ -2564,6 +2642,27 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if taccount, ok := d.GetOk("ibmcloud_account_id"); ok {
 		account = taccount.(string)
 	}
+	if crtype, ok := d.GetOk("iam_compute_resource_type"); ok {
+		iamComputeResourceType = crtype.(string)
+	}
+	if crfile, ok := d.GetOk("iam_cr_token_filename"); ok {
+		iamCRTokenFilename = crfile.(string)
+	}
+	if p, ok := d.GetOk("profile"); ok {
+		profile = p.(string)
+	}
+	if f, ok := d.GetOk("shared_credentials_file"); ok {
+		sharedCredentialsFile = f.(string)
+	}
+	if f, ok := d.GetOk("config_file"); ok {
+		configFile = f.(string)
+	}
+	if assumeProfile, ok := d.GetOk("assume_profile"); ok && len(assumeProfile.([]interface{})) > 0 && assumeProfile.([]interface{})[0] != nil {
+		assumeProfileMap := assumeProfile.([]interface{})[0].(map[string]interface{})
+		assumeProfileID = assumeProfileMap["id"].(string)
+		assumeProfileName = assumeProfileMap["name"].(string)
+		assumeProfileAccountID = assumeProfileMap["account_id"].(string)
+	}
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -2767,6 +2866,24 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	// iam_compute_resource_type - check environment variable
+	if iamComputeResourceType == "" {
+		if crType := os.Getenv("IC_IAM_COMPUTE_RESOURCE_TYPE"); crType != "" {
+			iamComputeResourceType = crType
+		} else if crType := os.Getenv("IBMCLOUD_IAM_COMPUTE_RESOURCE_TYPE"); crType != "" {
+			iamComputeResourceType = crType
+		}
+	}
+
+	// iam_cr_token_filename - check environment variable
+	if iamCRTokenFilename == "" {
+		if crFile := os.Getenv("IC_IAM_CR_TOKEN_FILENAME"); crFile != "" {
+			iamCRTokenFilename = crFile
+		} else if crFile := os.Getenv("IBMCLOUD_IAM_CR_TOKEN_FILENAME"); crFile != "" {
+			iamCRTokenFilename = crFile
+		}
+	}
+
 	// ibmcloud_account_id - check environment variable
 	if account == "" {
 		if accountId := os.Getenv("IC_ACCOUNT_ID"); accountId != "" {
@@ -2776,6 +2893,33 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	// profile - check environment variable
+	if profile == "" {
+		if p := os.Getenv("IC_PROFILE"); p != "" {
+			profile = p
+		} else if p := os.Getenv("IBMCLOUD_PROFILE"); p != "" {
+			profile = p
+		}
+	}
+
+	// shared_credentials_file - check environment variable
+	if sharedCredentialsFile == "" {
+		if f := os.Getenv("IC_SHARED_CREDENTIALS_FILE"); f != "" {
+			sharedCredentialsFile = f
+		} else if f := os.Getenv("IBMCLOUD_SHARED_CREDENTIALS_FILE"); f != "" {
+			sharedCredentialsFile = f
+		}
+	}
+
+	// config_file - check environment variable
+	if configFile == "" {
+		if f := os.Getenv("IC_CONFIG_FILE"); f != "" {
+			configFile = f
+		} else if f := os.Getenv("IBMCLOUD_CONFIG_FILE"); f != "" {
+			configFile = f
+		}
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
@@ -2793,7 +2937,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			region = reg
 		} else if reg := os.Getenv("BLUEMIX_REGION"); reg != "" {
 			region = reg
-		} else {
+		} else if profile == "" && configFile == "" {
+			// Otherwise the region of the profile or CLI configuration is used, see conns.Config.ClientSession
 			region = "us-south"
 		}
 	}
@@ -2863,6 +3008,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		IAMTrustedProfileID:   iamTrustedProfileId,
 		IAMTrustedProfileName: iamTrustedProfileName,
 		Account:               account,
+
+		IAMComputeResourceType: iamComputeResourceType,
+		IAMCRTokenFilename:     iamCRTokenFilename,
+
+		AssumeProfileID:        assumeProfileID,
+		AssumeProfileName:      assumeProfileName,
+		AssumeProfileAccountID: assumeProfileAccountID,
+
+		Profile:               profile,
+		SharedCredentialsFile: sharedCredentialsFile,
+		ConfigFile:            configFile,
 	}
 
 	return config.ClientSession()
@@ -9,19 +9,29 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/codeengine"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/dnsservices"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/schematics"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &frameworkProvider{}
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithActions            = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
 )
 
 // frameworkProvider is the provider implementation for the IBM Cloud Terraform Provider
@@ -58,10 +68,24 @@ type frameworkProviderModel struct {
 	IAMProfileName         types.String `tfsdk:"iam_profile_name"`
 	IAMToken               types.String `tfsdk:"iam_token"`
 	IAMRefreshToken        types.String `tfsdk:"iam_refresh_token"`
+	IAMComputeResourceType types.String `tfsdk:"iam_compute_resource_type"`
+	IAMCRTokenFilename     types.String `tfsdk:"iam_cr_token_filename"`
+	Profile                types.String `tfsdk:"profile"`
+	SharedCredentialsFile  types.String `tfsdk:"shared_credentials_file"`
+	ConfigFile             types.String `tfsdk:"config_file"`
 	Visibility             types.String `tfsdk:"visibility"`
 	PrivateEndpointType    types.String `tfsdk:"private_endpoint_type"`
 	EndpointsFilePath      types.String `tfsdk:"endpoints_file_path"`
 	IBMCloudAccountID      types.String `tfsdk:"ibmcloud_account_id"`
+
+	AssumeProfile []frameworkAssumeProfileModel `tfsdk:"assume_profile"`
+}
+
+// frameworkAssumeProfileModel describes the assume_profile block.
+type frameworkAssumeProfileModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	AccountID types.String `tfsdk:"account_id"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -186,6 +210,14 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "IAM Authentication refresh token",
 			},
+			"iam_compute_resource_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Compute resource to read the IAM compute resource token from to authenticate as the trusted profile: vpc or container.",
+			},
+			"iam_cr_token_filename": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the file that contains the compute resource token when iam_compute_resource_type is container.",
+			},
 			"visibility": schema.StringAttribute{
 				Optional:    true,
 				Description: "Visibility of the provider if it is private or public.",
@@ -198,11 +230,47 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the profile in the shared credentials file to read the provider configuration from.",
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the shared credentials file that contains the named profiles. Default is ~/.ibmcloud/credentials.",
+			},
+			"config_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the IBM Cloud CLI config.json to read the login session from, for example ~/.bluemix/config.json.",
+			},
 			"ibmcloud_account_id": schema.StringAttribute{
 				Optional:    true,
 				Description: "The IBM Cloud account ID",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"assume_profile": schema.ListNestedBlock{
+				Description: "Assume an IAM trusted profile, possibly in another account, with the token of the configured identity.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the IAM trusted profile to assume.",
+						},
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the IAM trusted profile to assume. Requires account_id.",
+						},
+						"account_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the account of the IAM trusted profile to assume.",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -232,6 +300,33 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	// profile - check environment variables
+	if config.Profile.IsNull() || config.Profile.ValueString() == "" {
+		if p := os.Getenv("IC_PROFILE"); p != "" {
+			config.Profile = types.StringValue(p)
+		} else if p := os.Getenv("IBMCLOUD_PROFILE"); p != "" {
+			config.Profile = types.StringValue(p)
+		}
+	}
+
+	// shared_credentials_file - check environment variables
+	if config.SharedCredentialsFile.IsNull() || config.SharedCredentialsFile.ValueString() == "" {
+		if f := os.Getenv("IC_SHARED_CREDENTIALS_FILE"); f != "" {
+			config.SharedCredentialsFile = types.StringValue(f)
+		} else if f := os.Getenv("IBMCLOUD_SHARED_CREDENTIALS_FILE"); f != "" {
+			config.SharedCredentialsFile = types.StringValue(f)
+		}
+	}
+
+	// config_file - check environment variables
+	if config.ConfigFile.IsNull() || config.ConfigFile.ValueString() == "" {
+		if f := os.Getenv("IC_CONFIG_FILE"); f != "" {
+			config.ConfigFile = types.StringValue(f)
+		} else if f := os.Getenv("IBMCLOUD_CONFIG_FILE"); f != "" {
+			config.ConfigFile = types.StringValue(f)
+		}
+	}
+
 	// region default: "us-south"
 	if config.Region.IsNull() || config.Region.ValueString() == "" {
 		if region := os.Getenv("IC_REGION"); region != "" {
@@ -242,7 +337,8 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 			config.Region = types.StringValue(region)
 		} else if region := os.Getenv("BLUEMIX_REGION"); region != "" {
 			config.Region = types.StringValue(region)
-		} else {
+		} else if config.Profile.ValueString() == "" && config.ConfigFile.ValueString() == "" {
+			// Otherwise the region of the profile or CLI configuration is used, see conns.Config.ClientSession
 			config.Region = types.StringValue("us-south")
 		}
 	}
@@ -376,6 +472,24 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	// iam_compute_resource_type - check environment variables
+	if config.IAMComputeResourceType.IsNull() || config.IAMComputeResourceType.ValueString() == "" {
+		if crType := os.Getenv("IC_IAM_COMPUTE_RESOURCE_TYPE"); crType != "" {
+			config.IAMComputeResourceType = types.StringValue(crType)
+		} else if crType := os.Getenv("IBMCLOUD_IAM_COMPUTE_RESOURCE_TYPE"); crType != "" {
+			config.IAMComputeResourceType = types.StringValue(crType)
+		}
+	}
+
+	// iam_cr_token_filename - check environment variables
+	if config.IAMCRTokenFilename.IsNull() || config.IAMCRTokenFilename.ValueString() == "" {
+		if crFile := os.Getenv("IC_IAM_CR_TOKEN_FILENAME"); crFile != "" {
+			config.IAMCRTokenFilename = types.StringValue(crFile)
+		} else if crFile := os.Getenv("IBMCLOUD_IAM_CR_TOKEN_FILENAME"); crFile != "" {
+			config.IAMCRTokenFilename = types.StringValue(crFile)
+		}
+	}
+
 	// ibmcloud_account_id - check environment variables
 	if config.IBMCloudAccountID.IsNull() || config.IBMCloudAccountID.ValueString() == "" {
 		if accountId := os.Getenv("IC_ACCOUNT_ID"); accountId != "" {
@@ -479,6 +593,26 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 	if !config.IBMCloudAccountID.IsNull() {
 		connConfig.Account = config.IBMCloudAccountID.ValueString()
 	}
+	if !config.IAMComputeResourceType.IsNull() {
+		connConfig.IAMComputeResourceType = config.IAMComputeResourceType.ValueString()
+	}
+	if !config.IAMCRTokenFilename.IsNull() {
+		connConfig.IAMCRTokenFilename = config.IAMCRTokenFilename.ValueString()
+	}
+	if !config.Profile.IsNull() {
+		connConfig.Profile = config.Profile.ValueString()
+	}
+	if !config.SharedCredentialsFile.IsNull() {
+		connConfig.SharedCredentialsFile = config.SharedCredentialsFile.ValueString()
+	}
+	if !config.ConfigFile.IsNull() {
+		connConfig.ConfigFile = config.ConfigFile.ValueString()
+	}
+	if len(config.AssumeProfile) > 0 {
+		connConfig.AssumeProfileID = config.AssumeProfile[0].ID.ValueString()
+		connConfig.AssumeProfileName = config.AssumeProfile[0].Name.ValueString()
+		connConfig.AssumeProfileAccountID = config.AssumeProfile[0].AccountID.ValueString()
+	}
 
 	// Initialize client session
 	session, err := connConfig.ClientSession()
@@ -492,9 +626,10 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Set the client session for resources, data sources, and actions
+	// Set the client session for resources, data sources, ephemeral resources, and actions
 	resp.DataSourceData = session
 	resp.ResourceData = session
+	resp.EphemeralResourceData = session
 	resp.ActionData = session
 }
 
@@ -505,15 +640,30 @@ func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Res
 }
 
 // DataSources defines the data sources implemented in the provider.
-// Initially empty - all data sources remain in SDKv2 provider.
+// Most data sources remain in SDKv2 provider.
 func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		schematics.NewSchematicsWorkspaceOutputsDataSource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		cos.NewCOSPresignedURLEphemeralResource,
+	}
 }
 
 // Actions defines the actions implemented in the provider.
 func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
+		cis.NewCISGlobalLoadBalancerTestFailoverAction,
 		codeengine.NewCodeEngineBuildRunAction,
+		dnsservices.NewPrivateDNSGLBTestFailoverAction,
 		kubernetes.NewContainerVpcBareMetalWorkerReloadAction,
+		power.NewPIVolumeGroupFailoverAction,
+		power.NewPIVolumeGroupFailbackAction,
+		schematics.NewSchematicsWorkspacePlanAction,
+		schematics.NewSchematicsWorkspaceApplyAction,
 	}
 }
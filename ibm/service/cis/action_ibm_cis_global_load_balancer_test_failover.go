@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var (
+	_ action.Action                   = &cisGLBTestFailoverAction{}
+	_ action.ActionWithConfigure      = &cisGLBTestFailoverAction{}
+	_ action.ActionWithValidateConfig = &cisGLBTestFailoverAction{}
+)
+
+const (
+	cisGLBFailoverPending   = "failing_over"
+	cisGLBFailoverCompleted = "failed_over"
+	// Time allowed to restore the pool once the test is complete, even when the invocation is cancelled.
+	cisGLBFailoverRestoreTimeout = 5 * time.Minute
+)
+
+func NewCISGlobalLoadBalancerTestFailoverAction() action.Action {
+	return &cisGLBTestFailoverAction{}
+}
+
+type cisGLBTestFailoverAction struct {
+	glbClient  *globalloadbalancerv1.GlobalLoadBalancerV1
+	poolClient *globalloadbalancerpoolsv0.GlobalLoadBalancerPoolsV0
+}
+
+type cisGLBTestFailoverModel struct {
+	CisID          types.String `tfsdk:"cis_id"`
+	DomainID       types.String `tfsdk:"domain_id"`
+	GlbID          types.String `tfsdk:"glb_id"`
+	PoolID         types.String `tfsdk:"pool_id"`
+	OriginName     types.String `tfsdk:"origin_name"`
+	ExpectedPoolID types.String `tfsdk:"expected_pool_id"`
+	Timeout        types.String `tfsdk:"timeout"`
+	VerifyRecovery types.Bool   `tfsdk:"verify_recovery"`
+}
+
+func (a *cisGLBTestFailoverAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_cis_global_load_balancer_test_failover"
+}
+
+func (a *cisGLBTestFailoverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tests the failover of a CIS global load balancer. The action disables the origins of an origin pool, or a single origin of the pool, waits until the health checks report the pool as unhealthy and the load balancer serves from the expected pool, and restores the pool afterwards, also when the test fails. The serving pool is the first enabled pool of the default pools that the health checks report as healthy, or the fallback pool when no default pool is healthy. Global load balancers with a steering policy, region pools or pop pools are not supported, and the pools must have a health check. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"cis_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The CRN of the CIS instance.",
+			},
+			"domain_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the domain of the global load balancer.",
+			},
+			"glb_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the global load balancer.",
+			},
+			"pool_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the origin pool to disable during the test.",
+			},
+			"origin_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the origin to disable in the pool. If not specified, all the origins of the pool are disabled.",
+			},
+			"expected_pool_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the pool that the global load balancer must fail over to.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the failover, and for the recovery when verified, for example `10m`. If not specified, defaults to `10m`.",
+			},
+			"verify_recovery": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait until the global load balancer serves from the original pool again once the pool is restored. If not specified, defaults to `true`.",
+			},
+		},
+	}
+}
+
+func (a *cisGLBTestFailoverAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config cisGLBTestFailoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Timeout.IsNull() && !config.Timeout.IsUnknown() {
+		if _, err := time.ParseDuration(config.Timeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout Format",
+				fmt.Sprintf("Failed to parse timeout '%s': %s. Expected format like '10m' or '1h'.", config.Timeout.ValueString(), err.Error()),
+			)
+		}
+	}
+	if !config.PoolID.IsUnknown() && !config.ExpectedPoolID.IsUnknown() &&
+		cisGLBFailoverID(config.PoolID.ValueString()) == cisGLBFailoverID(config.ExpectedPoolID.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_pool_id"),
+			"Invalid Expected Pool",
+			"The expected pool must be another pool than the pool that is disabled.",
+		)
+	}
+}
+
+func (a *cisGLBTestFailoverAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	glbClient, err := session.CisGLBClientSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create CIS Global Load Balancer Client",
+			"An unexpected error occurred when creating the CIS global load balancer client.\n\n"+
+				"CIS Client Error: "+err.Error(),
+		)
+		return
+	}
+	poolClient, err := session.CisGLBPoolClientSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create CIS Origin Pool Client",
+			"An unexpected error occurred when creating the CIS origin pool client.\n\n"+
+				"CIS Client Error: "+err.Error(),
+		)
+		return
+	}
+	a.glbClient = glbClient
+	a.poolClient = poolClient
+}
+
+func (a *cisGLBTestFailoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config cisGLBTestFailoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	crn := config.CisID.ValueString()
+	zoneID := cisGLBFailoverID(config.DomainID.ValueString())
+	glbID := cisGLBFailoverID(config.GlbID.ValueString())
+	poolID := cisGLBFailoverID(config.PoolID.ValueString())
+	expectedPoolID := cisGLBFailoverID(config.ExpectedPoolID.ValueString())
+	originName := config.OriginName.ValueString()
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		if d, err := time.ParseDuration(config.Timeout.ValueString()); err == nil {
+			timeout = d
+		}
+	}
+	a.glbClient.Crn = core.StringPtr(crn)
+	a.glbClient.ZoneIdentifier = core.StringPtr(zoneID)
+	a.poolClient.Crn = core.StringPtr(crn)
+
+	glb, pools, err := a.getGLB(ctx, glbID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Global Load Balancer", err.Error())
+		return
+	}
+	if err := cisGLBFailoverUnsupported(glb); err != nil {
+		resp.Diagnostics.AddError("Unsupported Global Load Balancer", fmt.Sprintf("The failover of global load balancer '%s' cannot be tested: %s.", glbID, err.Error()))
+		return
+	}
+	for _, id := range []string{poolID, expectedPoolID} {
+		if !slices.Contains(glb.DefaultPools, id) && (glb.FallbackPool == nil || *glb.FallbackPool != id) {
+			resp.Diagnostics.AddError(
+				"Pool Not Used by Global Load Balancer",
+				fmt.Sprintf("Pool '%s' is neither a default pool nor the fallback pool of global load balancer '%s'.", id, glbID),
+			)
+			return
+		}
+		if pool, ok := pools[id]; ok && (pool.Monitor == nil || *pool.Monitor == "") {
+			resp.Diagnostics.AddError(
+				"Pool Without Health Check",
+				fmt.Sprintf("Origin pool '%s' has no health check, so its health is not reported and the failover cannot be observed.", id),
+			)
+			return
+		}
+	}
+	original, ok := pools[poolID]
+	if !ok {
+		resp.Diagnostics.AddError("Pool Not Found", fmt.Sprintf("Origin pool '%s' was not found in CIS instance '%s'.", poolID, crn))
+		return
+	}
+	originalServingPool := cisGLBServingPool(glb, pools)
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Global load balancer '%s' serves from pool '%s'", glbID, originalServingPool),
+	})
+	if originalServingPool == expectedPoolID {
+		resp.Diagnostics.AddError(
+			"Global Load Balancer Already Failed Over",
+			fmt.Sprintf("Global load balancer '%s' already serves from the expected pool '%s', the failover cannot be tested.", glbID, expectedPoolID),
+		)
+		return
+	}
+
+	// The origins are disabled rather than the pool, so that the pool keeps its place in the
+	// default pools and the failover only happens once the health checks report the pool unhealthy.
+	disabled := original
+	disabled.Origins = append([]globalloadbalancerpoolsv0.LoadBalancerPoolPackOriginsItem{}, original.Origins...)
+	found := false
+	for i, origin := range disabled.Origins {
+		if originName == "" || (origin.Name != nil && *origin.Name == originName) {
+			origin.Enabled = core.BoolPtr(false)
+			disabled.Origins[i] = origin
+			found = true
+		}
+	}
+	if !found {
+		resp.Diagnostics.AddError("Origin Not Found", fmt.Sprintf("Origin pool '%s' has no origin named '%s'.", poolID, originName))
+		return
+	}
+
+	if err := a.testFailover(ctx, glbID, poolID, originName, expectedPoolID, original, disabled, timeout, resp); err != nil {
+		resp.Diagnostics.AddError(
+			"Global Load Balancer Failover Failed",
+			fmt.Sprintf("Global load balancer '%s' did not fail over to pool '%s': %s", glbID, expectedPoolID, err.Error()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.VerifyRecovery.IsNull() || config.VerifyRecovery.ValueBool() {
+		if err := a.waitForServingPool(ctx, glbID, originalServingPool, "", timeout, resp.SendProgress); err != nil {
+			resp.Diagnostics.AddError(
+				"Global Load Balancer Recovery Failed",
+				fmt.Sprintf("Global load balancer '%s' did not return to pool '%s' once the pool was restored: %s", glbID, originalServingPool, err.Error()),
+			)
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Global load balancer '%s' serves from pool '%s' again", glbID, originalServingPool),
+		})
+	}
+}
+
+// testFailover disables the origins of the pool and waits for the failover to the expected pool. The
+// pool is restored when the function returns, whatever the outcome of the test, also when the
+// invocation is cancelled or panics.
+func (a *cisGLBTestFailoverAction) testFailover(ctx context.Context, glbID, poolID, originName, expectedPoolID string, original, disabled globalloadbalancerpoolsv0.LoadBalancerPoolPack, timeout time.Duration, resp *action.InvokeResponse) error {
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Disabling %s...", cisGLBFailoverTarget(poolID, originName)),
+	})
+	if err := a.editPool(ctx, poolID, disabled); err != nil {
+		return fmt.Errorf("failed to disable %s: %s", cisGLBFailoverTarget(poolID, originName), err.Error())
+	}
+	defer func() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Restoring %s...", cisGLBFailoverTarget(poolID, originName)),
+		})
+		restoreCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cisGLBFailoverRestoreTimeout)
+		defer cancel()
+		if err := a.editPool(restoreCtx, poolID, original); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Restore Pool",
+				fmt.Sprintf("%s. Origin pool '%s' must be restored manually.", err.Error(), poolID),
+			)
+		}
+	}()
+
+	if err := a.waitForServingPool(ctx, glbID, expectedPoolID, poolID, timeout, resp.SendProgress); err != nil {
+		return err
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Global load balancer '%s' failed over to pool '%s'", glbID, expectedPoolID),
+	})
+	return nil
+}
+
+// getGLB returns the global load balancer and the origin pools of the CIS instance by ID.
+func (a *cisGLBTestFailoverAction) getGLB(ctx context.Context, glbID string) (*globalloadbalancerv1.LoadBalancerPack, map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack, error) {
+	glbResult, resp, err := a.glbClient.GetLoadBalancerSettingsWithContext(ctx, a.glbClient.NewGetLoadBalancerSettingsOptions(glbID))
+	if err != nil || glbResult == nil || glbResult.Result == nil {
+		return nil, nil, fmt.Errorf("failed to get global load balancer '%s': %v\n%s", glbID, err, resp)
+	}
+	poolsResult, resp, err := a.poolClient.ListAllLoadBalancerPoolsWithContext(ctx, a.poolClient.NewListAllLoadBalancerPoolsOptions())
+	if err != nil || poolsResult == nil {
+		return nil, nil, fmt.Errorf("failed to list origin pools: %v\n%s", err, resp)
+	}
+	pools := make(map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack, len(poolsResult.Result))
+	for _, pool := range poolsResult.Result {
+		if pool.ID != nil {
+			pools[*pool.ID] = pool
+		}
+	}
+	return glbResult.Result, pools, nil
+}
+
+// editPool replaces the settings of an origin pool with the settings of pool.
+func (a *cisGLBTestFailoverAction) editPool(ctx context.Context, poolID string, pool globalloadbalancerpoolsv0.LoadBalancerPoolPack) error {
+	opt := a.poolClient.NewEditLoadBalancerPoolOptions(poolID)
+	opt.Name = pool.Name
+	opt.Description = pool.Description
+	opt.Enabled = pool.Enabled
+	opt.MinimumOrigins = pool.MinimumOrigins
+	opt.CheckRegions = pool.CheckRegions
+	opt.Monitor = pool.Monitor
+	opt.NotificationEmail = pool.NotificationEmail
+	origins := make([]globalloadbalancerpoolsv0.LoadBalancerPoolReqOriginsItem, 0, len(pool.Origins))
+	for _, origin := range pool.Origins {
+		origins = append(origins, globalloadbalancerpoolsv0.LoadBalancerPoolReqOriginsItem{
+			Name:    origin.Name,
+			Address: origin.Address,
+			Enabled: origin.Enabled,
+			Weight:  origin.Weight,
+		})
+	}
+	opt.SetOrigins(origins)
+	if _, resp, err := a.poolClient.EditLoadBalancerPoolWithContext(ctx, opt); err != nil {
+		return fmt.Errorf("failed to update origin pool '%s': %s\n%s", poolID, err.Error(), resp)
+	}
+	return nil
+}
+
+// waitForServingPool waits until the global load balancer serves from the pool, and until the health
+// checks report the unhealthy pool as unhealthy when it is set. Each change of the serving pool is
+// reported as progress.
+func (a *cisGLBTestFailoverAction) waitForServingPool(ctx context.Context, glbID, poolID, unhealthyPoolID string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) error {
+	log.Printf("Waiting for global load balancer (%s) to serve from pool %s.", glbID, poolID)
+
+	lastPool := ""
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{cisGLBFailoverPending},
+		Target:  []string{cisGLBFailoverCompleted},
+		Refresh: func() (interface{}, string, error) {
+			glb, pools, err := a.getGLB(ctx, glbID)
+			if err != nil {
+				return nil, "", err
+			}
+			serving := cisGLBServingPool(glb, pools)
+			if serving != lastPool {
+				sendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Global load balancer '%s' serves from pool '%s'", glbID, serving),
+				})
+				lastPool = serving
+			}
+			if unhealthy, ok := pools[unhealthyPoolID]; ok && (unhealthy.Healthy == nil || *unhealthy.Healthy) {
+				return glb, cisGLBFailoverPending, nil
+			}
+			if serving == poolID {
+				return glb, cisGLBFailoverCompleted, nil
+			}
+			return glb, cisGLBFailoverPending, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// cisGLBServingPool returns the pool that the global load balancer serves from when it does not
+// steer the traffic: the first enabled default pool that the health checks report as healthy, or the
+// fallback pool. The health of the origins is not evaluated here, CIS reports a pool as unhealthy
+// when it has less than the minimum number of enabled and healthy origins.
+func cisGLBServingPool(glb *globalloadbalancerv1.LoadBalancerPack, pools map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack) string {
+	for _, id := range glb.DefaultPools {
+		pool, ok := pools[id]
+		if ok && (pool.Enabled == nil || *pool.Enabled) && pool.Healthy != nil && *pool.Healthy {
+			return id
+		}
+	}
+	if glb.FallbackPool == nil {
+		return ""
+	}
+	return *glb.FallbackPool
+}
+
+// cisGLBFailoverUnsupported returns an error when the global load balancer does not serve from the
+// first healthy default pool, in which case the serving pool cannot be determined.
+func cisGLBFailoverUnsupported(glb *globalloadbalancerv1.LoadBalancerPack) error {
+	if glb.SteeringPolicy != nil && *glb.SteeringPolicy != "" && *glb.SteeringPolicy != globalloadbalancerv1.LoadBalancerPack_SteeringPolicy_Off {
+		return fmt.Errorf("the steering policy is '%s'", *glb.SteeringPolicy)
+	}
+	if regionPools, ok := glb.RegionPools.(map[string]interface{}); ok && len(regionPools) > 0 {
+		return fmt.Errorf("region pools are configured")
+	}
+	if popPools, ok := glb.PopPools.(map[string]interface{}); ok && len(popPools) > 0 {
+		return fmt.Errorf("pop pools are configured")
+	}
+	return nil
+}
+
+// cisGLBFailoverID returns the CIS ID of a Terraform ID, which may include the IDs of the parent
+// resources.
+func cisGLBFailoverID(id string) string {
+	return strings.SplitN(id, ":", 2)[0]
+}
+
+func cisGLBFailoverTarget(poolID, originName string) string {
+	if originName == "" {
+		return fmt.Sprintf("the origins of origin pool '%s'", poolID)
+	}
+	return fmt.Sprintf("origin '%s' of pool '%s'", originName, poolID)
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisGlobalLoadBalancerTestFailoverActionBasic(t *testing.T) {
+	rnd := fmt.Sprintf("tf-glb-failover-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheckCis(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisGlobalLoadBalancerTestFailoverActionConfig(rnd, "ibm_cis_origin_pool.secondary.id", "10m"),
+			},
+		},
+	})
+}
+
+func TestAccIBMCisGlobalLoadBalancerTestFailoverActionSamePool(t *testing.T) {
+	rnd := fmt.Sprintf("tf-glb-failover-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheckCis(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCisGlobalLoadBalancerTestFailoverActionConfig(rnd, "ibm_cis_origin_pool.primary.id", "10m"),
+				ExpectError: regexp.MustCompile("Invalid Expected Pool"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisGlobalLoadBalancerTestFailoverActionConfig(rnd, expectedPool, timeout string) string {
+	return testAccCheckCisHealthcheckConfigCisDSBasic(rnd, acc.CisDomainStatic) + fmt.Sprintf(`
+	resource "ibm_cis_origin_pool" "primary" {
+		cis_id        = data.ibm_cis.cis.id
+		name          = "%[1]s-primary"
+		check_regions = ["WEU"]
+		origins {
+			name    = "primary-1"
+			address = "www.google.com"
+			enabled = true
+		}
+		enabled = true
+		monitor = ibm_cis_healthcheck.health_check.monitor_id
+	}
+	resource "ibm_cis_origin_pool" "secondary" {
+		cis_id        = data.ibm_cis.cis.id
+		name          = "%[1]s-secondary"
+		check_regions = ["WEU"]
+		origins {
+			name    = "secondary-1"
+			address = "www.ibm.com"
+			enabled = true
+		}
+		enabled = true
+		monitor = ibm_cis_healthcheck.health_check.monitor_id
+	}
+	resource "ibm_cis_global_load_balancer" "glb" {
+		cis_id           = data.ibm_cis.cis.id
+		domain_id        = data.ibm_cis_domain.cis_domain.id
+		name             = "%[1]s.%[2]s"
+		fallback_pool_id = ibm_cis_origin_pool.secondary.id
+		default_pool_ids = [ibm_cis_origin_pool.primary.id, ibm_cis_origin_pool.secondary.id]
+	}
+
+	action "ibm_cis_global_load_balancer_test_failover" "test" {
+		config {
+			cis_id           = data.ibm_cis.cis.id
+			domain_id        = data.ibm_cis_domain.cis_domain.id
+			glb_id           = ibm_cis_global_load_balancer.glb.glb_id
+			pool_id          = ibm_cis_origin_pool.primary.id
+			expected_pool_id = %[3]s
+			timeout          = "%[4]s"
+		}
+	}
+
+	resource "terraform_data" "test" {
+		input = ibm_cis_global_load_balancer.glb.id
+
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_cis_global_load_balancer_test_failover.test]
+			}
+		}
+	}
+	`, rnd, acc.CisDomainStatic, expectedPool, timeout)
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
+	"github.com/IBM/networking-go-sdk/globalloadbalancerv1"
+)
+
+func TestCISGLBServingPool(t *testing.T) {
+	pool := func(enabled bool, healthy *bool, origins ...globalloadbalancerpoolsv0.LoadBalancerPoolPackOriginsItem) globalloadbalancerpoolsv0.LoadBalancerPoolPack {
+		return globalloadbalancerpoolsv0.LoadBalancerPoolPack{Enabled: core.BoolPtr(enabled), Healthy: healthy, MinimumOrigins: core.Int64Ptr(1), Origins: origins}
+	}
+	disabledOrigin := globalloadbalancerpoolsv0.LoadBalancerPoolPackOriginsItem{Enabled: core.BoolPtr(false), Healthy: core.BoolPtr(true)}
+	glb := &globalloadbalancerv1.LoadBalancerPack{
+		DefaultPools: []string{"primary", "secondary"},
+		FallbackPool: core.StringPtr("fallback"),
+	}
+
+	tests := []struct {
+		name  string
+		pools map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack
+		want  string
+	}{
+		{"primary healthy", map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack{
+			"primary":   pool(true, core.BoolPtr(true)),
+			"secondary": pool(true, core.BoolPtr(true)),
+		}, "primary"},
+		{"primary reported unhealthy", map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack{
+			"primary":   pool(true, core.BoolPtr(false)),
+			"secondary": pool(true, core.BoolPtr(true)),
+		}, "secondary"},
+		// Disabling an origin only fails over once the health checks report the pool unhealthy.
+		{"primary origin disabled but reported healthy", map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack{
+			"primary":   pool(true, core.BoolPtr(true), disabledOrigin),
+			"secondary": pool(true, core.BoolPtr(true)),
+		}, "primary"},
+		{"primary health not reported", map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack{
+			"primary":   pool(true, nil),
+			"secondary": pool(true, core.BoolPtr(true)),
+		}, "secondary"},
+		{"all unhealthy", map[string]globalloadbalancerpoolsv0.LoadBalancerPoolPack{
+			"primary":   pool(true, core.BoolPtr(false)),
+			"secondary": pool(false, core.BoolPtr(true)),
+		}, "fallback"},
+	}
+	for _, tt := range tests {
+		if got := cisGLBServingPool(glb, tt.pools); got != tt.want {
+			t.Errorf("%s: cisGLBServingPool() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if got := cisGLBFailoverID("pool:crn:v1:bluemix"); got != "pool" {
+		t.Errorf("cisGLBFailoverID() = %q, want %q", got, "pool")
+	}
+}
+
+func TestCISGLBFailoverUnsupported(t *testing.T) {
+	tests := []struct {
+		name        string
+		glb         globalloadbalancerv1.LoadBalancerPack
+		unsupported bool
+	}{
+		{"no steering", globalloadbalancerv1.LoadBalancerPack{SteeringPolicy: core.StringPtr("off"), RegionPools: map[string]interface{}{}, PopPools: map[string]interface{}{}}, false},
+		{"random steering", globalloadbalancerv1.LoadBalancerPack{SteeringPolicy: core.StringPtr("random")}, true},
+		{"region pools", globalloadbalancerv1.LoadBalancerPack{RegionPools: map[string]interface{}{"WNAM": []interface{}{"primary"}}}, true},
+		{"pop pools", globalloadbalancerv1.LoadBalancerPack{PopPools: map[string]interface{}{"LAX": []interface{}{"primary"}}}, true},
+	}
+	for _, tt := range tests {
+		if err := cisGLBFailoverUnsupported(&tt.glb); (err != nil) != tt.unsupported {
+			t.Errorf("%s: cisGLBFailoverUnsupported() = %v, want unsupported %t", tt.name, err, tt.unsupported)
+		}
+	}
+}
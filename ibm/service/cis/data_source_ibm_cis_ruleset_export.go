@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	CISRulesetExportPhases           = "phases"
+	CISRulesetExportHCL              = "hcl"
+	CISRulesetExportJSON             = "json"
+	CISRulesetExportActionParameters = "action_parameters_json"
+)
+
+func DataSourceIBMCISRulesetExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCISRulesetExportRead,
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_cis_ruleset_export",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			CISRulesetExportPhases: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Phases of the entrypoint rulesets to export. All the entrypoint rulesets of the domain are exported by default",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			CISRulesetsEntryPointOutput: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Entrypoint rulesets of the domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						CISRulesetsId: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the ruleset",
+						},
+						CISRulesetsName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the ruleset",
+						},
+						CISRulesetsDescription: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the ruleset",
+						},
+						CISRulesetsPhase: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Phase of the ruleset",
+						},
+						CISRulesetsVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the ruleset",
+						},
+						CISRulesetsRules: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Rules of the ruleset",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									CISRulesetsRuleId: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the rule",
+									},
+									CISRulesetsRuleAction: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Action of the rule",
+									},
+									CISRulesetsRuleExpression: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Expression of the rule",
+									},
+									CISRulesetsRuleActionDescription: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Description of the rule",
+									},
+									CISRulesetsRuleActionEnabled: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the rule is enabled",
+									},
+									CISRulesetsRuleRef: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Reference of the rule",
+									},
+									CISRulesetsRuleActionCategories: {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Categories of the rule",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									CISRulesetExportActionParameters: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Action parameters of the rule, in JSON",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			CISRulesetExportHCL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entrypoint rulesets rendered as ibm_cis_ruleset_entrypoint_version resources",
+			},
+			CISRulesetExportJSON: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entrypoint rulesets in JSON",
+			},
+		},
+	}
+}
+
+func DataSourceIBMCISRulesetExportValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	iBMCISRulesetExportValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_ruleset_export",
+		Schema:       validateSchema}
+	return &iBMCISRulesetExportValidator
+}
+
+func dataSourceIBMCISRulesetExportRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisRulesetsSession()
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while getting the CisRulesetsSession %s", err)
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneId, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneId)
+
+	phases := flex.ExpandStringList(d.Get(CISRulesetExportPhases).([]interface{}))
+	if len(phases) == 0 {
+		result, resp, err := sess.GetZoneRulesets(sess.NewGetZoneRulesetsOptions())
+		if err != nil {
+			return flex.FmtErrorf("[ERROR] Error while listing the zone rulesets %s %s", err, resp)
+		}
+		for _, ruleset := range result.Result {
+			if ruleset.Kind != nil && *ruleset.Kind == rulesetsv1.ListedRuleset_Kind_Zone && ruleset.Phase != nil {
+				phases = append(phases, *ruleset.Phase)
+			}
+		}
+		sort.Strings(phases)
+	}
+
+	rulesets := make([]rulesetsv1.RulesetDetails, 0, len(phases))
+	for _, phase := range phases {
+		result, resp, err := sess.GetZoneEntrypointRuleset(sess.NewGetZoneEntrypointRulesetOptions(phase))
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return flex.FmtErrorf("[ERROR] Error while getting the %s entrypoint ruleset %s %s", phase, err, resp)
+		}
+		if result.Result != nil {
+			rulesets = append(rulesets, *result.Result)
+		}
+	}
+
+	rulesetList := make([]map[string]interface{}, 0, len(rulesets))
+	for _, ruleset := range rulesets {
+		rules := make([]map[string]interface{}, 0, len(ruleset.Rules))
+		for _, rule := range ruleset.Rules {
+			ruleOutput := map[string]interface{}{
+				CISRulesetsRuleId:                rule.ID,
+				CISRulesetsRuleAction:            rule.Action,
+				CISRulesetsRuleExpression:        rule.Expression,
+				CISRulesetsRuleActionDescription: rule.Description,
+				CISRulesetsRuleActionEnabled:     rule.Enabled,
+				CISRulesetsRuleRef:               rule.Ref,
+				CISRulesetsRuleActionCategories:  rule.Categories,
+			}
+			if rule.ActionParameters != nil {
+				actionParameters, err := json.Marshal(rule.ActionParameters)
+				if err != nil {
+					return flex.FmtErrorf("[ERROR] Error while encoding the action parameters of rule %s %s", *rule.ID, err)
+				}
+				ruleOutput[CISRulesetExportActionParameters] = string(actionParameters)
+			}
+			rules = append(rules, ruleOutput)
+		}
+		rulesetList = append(rulesetList, map[string]interface{}{
+			CISRulesetsId:          ruleset.ID,
+			CISRulesetsName:        ruleset.Name,
+			CISRulesetsDescription: ruleset.Description,
+			CISRulesetsPhase:       ruleset.Phase,
+			CISRulesetsVersion:     ruleset.Version,
+			CISRulesetsRules:       rules,
+		})
+	}
+
+	rulesetsJSON, err := json.MarshalIndent(rulesets, "", "  ")
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while encoding the rulesets %s", err)
+	}
+
+	d.SetId(zoneId + ":" + crn)
+	d.Set(cisDomainID, zoneId)
+	d.Set(cisID, crn)
+	d.Set(CISRulesetsEntryPointOutput, rulesetList)
+	d.Set(CISRulesetExportHCL, renderCISRulesetsHCL(crn, zoneId, rulesets))
+	d.Set(CISRulesetExportJSON, string(rulesetsJSON))
+	return nil
+}
+
+// renderCISRulesetsHCL renders the entrypoint rulesets as ibm_cis_ruleset_entrypoint_version
+// resources. The IDs of the rules are left out, so that the rules are created again when the
+// configuration is applied to another domain.
+func renderCISRulesetsHCL(crn, zoneId string, rulesets []rulesetsv1.RulesetDetails) string {
+	w := &cisHCLWriter{}
+	for i, ruleset := range rulesets {
+		if i > 0 {
+			w.line("")
+		}
+		w.open(fmt.Sprintf("resource \"ibm_cis_ruleset_entrypoint_version\" %s", cisHCLString(cisHCLLabel(ruleset.Phase))))
+		w.attr("cis_id", crn)
+		w.attr("domain_id", zoneId)
+		w.attr("phase", ruleset.Phase)
+		w.line("")
+		w.open("rulesets")
+		w.attr("name", ruleset.Name)
+		w.attr("description", ruleset.Description)
+		for _, rule := range ruleset.Rules {
+			w.line("")
+			w.open("rules")
+			w.attr("action", rule.Action)
+			w.attr("expression", rule.Expression)
+			w.attr("description", rule.Description)
+			w.attr("enabled", rule.Enabled)
+			w.attr("ref", rule.Ref)
+			if p := rule.ActionParameters; p != nil {
+				w.open("action_parameters")
+				w.attr("id", p.ID)
+				w.attr("ruleset", p.Ruleset)
+				w.attr("rulesets", p.Rulesets)
+				w.attr("version", p.Version)
+				w.attr("phases", p.Phases)
+				w.attr("products", p.Products)
+				if p.Response != nil {
+					w.open("response")
+					w.attr("content", p.Response.Content)
+					w.attr("content_type", p.Response.ContentType)
+					w.attr("status_code", p.Response.StatusCode)
+					w.close()
+				}
+				if o := p.Overrides; o != nil {
+					w.open("overrides")
+					w.attr("action", o.Action)
+					w.attr("enabled", o.Enabled)
+					for _, r := range o.Rules {
+						w.open("override_rules")
+						w.attr("rule_id", r.ID)
+						w.attr("enabled", r.Enabled)
+						w.attr("action", r.Action)
+						w.attr("sensitivity_level", r.SensitivityLevel)
+						w.attr("score_threshold", r.ScoreThreshold)
+						w.close()
+					}
+					for _, c := range o.Categories {
+						w.open("categories")
+						w.attr("category", c.Category)
+						w.attr("enabled", c.Enabled)
+						w.attr("action", c.Action)
+						w.close()
+					}
+					w.close()
+				}
+				w.close()
+			}
+			w.close()
+		}
+		w.close()
+		w.close()
+	}
+	return w.buf.String()
+}
+
+// cisHCLWriter writes indented HCL blocks and attributes, skipping the attributes that are not set.
+type cisHCLWriter struct {
+	buf    bytes.Buffer
+	indent int
+}
+
+func (w *cisHCLWriter) line(s string) {
+	if s != "" {
+		w.buf.WriteString(strings.Repeat("  ", w.indent))
+	}
+	w.buf.WriteString(s)
+	w.buf.WriteString("\n")
+}
+
+func (w *cisHCLWriter) open(header string) {
+	w.line(header + " {")
+	w.indent++
+}
+
+func (w *cisHCLWriter) close() {
+	w.indent--
+	w.line("}")
+}
+
+func (w *cisHCLWriter) attr(name string, value interface{}) {
+	var rendered string
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return
+		}
+		rendered = cisHCLString(v)
+	case *string:
+		if v == nil || *v == "" {
+			return
+		}
+		rendered = cisHCLString(*v)
+	case *bool:
+		if v == nil {
+			return
+		}
+		rendered = fmt.Sprintf("%t", *v)
+	case *int64:
+		if v == nil {
+			return
+		}
+		rendered = fmt.Sprintf("%d", *v)
+	case []string:
+		if len(v) == 0 {
+			return
+		}
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, cisHCLString(item))
+		}
+		rendered = "[" + strings.Join(items, ", ") + "]"
+	default:
+		return
+	}
+	w.line(fmt.Sprintf("%s = %s", name, rendered))
+}
+
+// cisHCLString quotes a string for HCL. Template sequences are escaped so that they are not
+// interpolated.
+func cisHCLString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(s)
+	quoted := strings.TrimSuffix(buf.String(), "\n")
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+// cisHCLLabel returns a resource name for a ruleset phase.
+func cisHCLLabel(phase *string) string {
+	if phase == nil || *phase == "" {
+		return "ruleset"
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, *phase)
+}
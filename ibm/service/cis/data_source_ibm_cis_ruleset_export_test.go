@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCISRulesetExportDataSource_Basic(t *testing.T) {
+	name := "data.ibm_cis_ruleset_export.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisRulesetExportDataSource_basic("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "rulesets.0.phase", "http_request_firewall_custom"),
+					resource.TestCheckResourceAttrSet(name, "hcl"),
+					resource.TestCheckResourceAttrSet(name, "json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisRulesetExportDataSource_basic(id string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	data "ibm_cis_ruleset_export" "%[1]s" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		phases    = ["http_request_firewall_custom"]
+	}
+`, id)
+}
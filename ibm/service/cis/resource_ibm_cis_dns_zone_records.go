@@ -0,0 +1,843 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSZoneRecordsZoneFile      = "zone_file"
+	cisDNSZoneRecordsRecord        = "record"
+	cisDNSZoneRecordsAuthoritative = "authoritative"
+	cisDNSZoneRecordsRecords       = "records"
+	cisDNSZoneRecordsRecordIDs     = "record_ids"
+	cisDNSZoneRecordsDeleted       = "deleted_records"
+)
+
+// cisDNSZoneRecordsTypes are the record types that can be managed from a zone file. Their value
+// is set with the content and priority of a record, without the structured data argument.
+var cisDNSZoneRecordsTypes = []string{
+	cisDNSRecordTypeA,
+	cisDNSRecordTypeAAAA,
+	cisDNSRecordTypeCNAME,
+	cisDNSRecordTypeMX,
+	cisDNSRecordTypeNS,
+	cisDNSRecordTypePTR,
+	cisDNSRecordTypeSPF,
+	cisDNSRecordTypeTXT,
+}
+
+func ResourceIBMCISDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCISDNSZoneRecordsUpdate,
+		Read:     resourceIBMCISDNSZoneRecordsRead,
+		Update:   resourceIBMCISDNSZoneRecordsUpdate,
+		Delete:   resourceIBMCISDNSZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMCISDNSZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_dns_zone_records",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSZoneRecordsZoneFile: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{cisDNSZoneRecordsZoneFile, cisDNSZoneRecordsRecord},
+				Description:  "Content of a BIND zone file with the records of the zone",
+			},
+			cisDNSZoneRecordsRecord: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{cisDNSZoneRecordsZoneFile, cisDNSZoneRecordsRecord},
+				Description:  "Records of the zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Record name, relative to the zone unless it ends with a dot. Use @ for the zone apex",
+						},
+						cisDNSRecordType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues(cisDNSZoneRecordsTypes),
+							Description:  "Record type",
+						},
+						cisDNSRecordContent: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Record content",
+						},
+						cisDNSRecordTTL: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "Record time to live, 1 for automatic",
+						},
+						cisDNSRecordPriority: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Priority of an MX record",
+						},
+					},
+				},
+			},
+			cisDNSZoneRecordsAuthoritative: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the records of the zone that are not in the zone file or record list",
+			},
+			cisDNSZoneRecordsRecords: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Records managed in the zone. When authoritative is set, all the records of the supported types in the zone",
+				Elem:        cisZoneRecordsComputedElem,
+			},
+			cisDNSZoneRecordsRecordIDs: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "IDs of the records of the zone file or record list, keyed by name, type and content",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			cisDNSZoneRecordsDeleted: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Records deleted from the zone by the last apply that deleted records",
+				Elem:        cisZoneRecordsComputedElem,
+			},
+		},
+	}
+}
+
+var cisZoneRecordsComputedElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		cisDNSRecordName: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Fully qualified record name",
+		},
+		cisDNSRecordType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Record type",
+		},
+		cisDNSRecordContent: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Record content",
+		},
+		cisDNSRecordTTL: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Record time to live",
+		},
+		cisDNSRecordPriority: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Record priority",
+		},
+	},
+}
+
+func ResourceIBMCISDNSZoneRecordsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	return &validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_zone_records",
+		Schema:       validateSchema,
+	}
+}
+
+// cisZoneRecord is a DNS record of a zone, with a fully qualified name and a normalized content so
+// that records parsed from a zone file can be compared to the records returned by CIS.
+type cisZoneRecord struct {
+	ID       string
+	Name     string
+	Type     string
+	Content  string
+	TTL      int
+	Priority int
+}
+
+// key identifies a record in the zone. Records with the same key are the same record, possibly
+// with a different time to live or priority.
+func (r cisZoneRecord) key() string {
+	return fmt.Sprintf("%s %s %s", r.Name, r.Type, r.Content)
+}
+
+func (r cisZoneRecord) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		cisDNSRecordName:     r.Name,
+		cisDNSRecordType:     r.Type,
+		cisDNSRecordContent:  r.Content,
+		cisDNSRecordTTL:      r.TTL,
+		cisDNSRecordPriority: r.Priority,
+	}
+}
+
+func resourceIBMCISDNSZoneRecordsCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(cisID) || !diff.NewValueKnown(cisDomainID) ||
+		!diff.NewValueKnown(cisDNSZoneRecordsZoneFile) || !diff.NewValueKnown(cisDNSZoneRecordsRecord) {
+		diff.SetNewComputed(cisDNSZoneRecordsRecords)
+		diff.SetNewComputed(cisDNSZoneRecordsRecordIDs)
+		diff.SetNewComputed(cisDNSZoneRecordsDeleted)
+		return nil
+	}
+
+	crn := diff.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(diff.Get(cisDomainID).(string))
+	zoneName, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	desired, err := desiredCISZoneRecords(diff, zoneName)
+	if err != nil {
+		return err
+	}
+
+	// The records attribute holds the managed records of the live zone, so setting it to the
+	// desired records shows each record that is created, updated or deleted in the plan.
+	old := map[string]cisZoneRecord{}
+	for _, r := range diff.Get(cisDNSZoneRecordsRecords).(*schema.Set).List() {
+		record := cisZoneRecordFromMap(r.(map[string]interface{}))
+		old[record.key()] = record
+	}
+	// The state only holds the managed records until authoritative is enabled, and holds no records
+	// before the resource is created, so the records that will be pruned are read from the zone.
+	if diff.HasChange(cisDNSZoneRecordsAuthoritative) && diff.Get(cisDNSZoneRecordsAuthoritative).(bool) {
+		sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+		if err != nil {
+			return err
+		}
+		sess.Crn = core.StringPtr(crn)
+		sess.ZoneIdentifier = core.StringPtr(zoneID)
+		live, err := listCISZoneRecords(sess)
+		if err != nil {
+			return err
+		}
+		for _, record := range live {
+			old[record.key()] = record
+		}
+	}
+	changed := len(old) != len(desired)
+	desiredByKey := map[string]bool{}
+	for _, record := range desired {
+		desiredByKey[record.key()] = true
+		if o, ok := old[record.key()]; !ok || o.TTL != record.TTL || o.Priority != record.Priority {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	// The records that will be deleted are shown in the plan, including when the resource is
+	// created and the zone has no records in the state yet.
+	deleted := make([]interface{}, 0)
+	for key, record := range old {
+		if !desiredByKey[key] {
+			deleted = append(deleted, record.toMap())
+		}
+	}
+	if len(deleted) > 0 {
+		if err := diff.SetNew(cisDNSZoneRecordsDeleted, deleted); err != nil {
+			return err
+		}
+	}
+
+	records := make([]interface{}, 0, len(desired))
+	for _, record := range desired {
+		records = append(records, record.toMap())
+	}
+	if err := diff.SetNew(cisDNSZoneRecordsRecords, records); err != nil {
+		return err
+	}
+	return diff.SetNewComputed(cisDNSZoneRecordsRecordIDs)
+}
+
+func resourceIBMCISDNSZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	zoneName, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	desired, err := desiredCISZoneRecords(d, zoneName)
+	if err != nil {
+		return err
+	}
+	live, err := listCISZoneRecords(sess)
+	if err != nil {
+		return err
+	}
+	liveByKey := map[string]cisZoneRecord{}
+	for _, record := range live {
+		liveByKey[record.key()] = record
+	}
+	desiredByKey := map[string]cisZoneRecord{}
+	for _, record := range desired {
+		desiredByKey[record.key()] = record
+	}
+
+	// Delete the records that are no longer wanted first, so that a record can be replaced by a
+	// record that conflicts with it, such as a CNAME replacing an A record of the same name.
+	var deletes []cisZoneRecord
+	if d.Get(cisDNSZoneRecordsAuthoritative).(bool) {
+		for _, record := range live {
+			if _, ok := desiredByKey[record.key()]; !ok {
+				deletes = append(deletes, record)
+			}
+		}
+	} else {
+		for key := range d.Get(cisDNSZoneRecordsRecordIDs).(map[string]interface{}) {
+			if record, ok := liveByKey[key]; ok {
+				if _, ok := desiredByKey[key]; !ok {
+					deletes = append(deletes, record)
+				}
+			}
+		}
+	}
+
+	// The record IDs are saved when a change fails, so that the records created or adopted so far
+	// are still managed by the resource.
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	recordIDs := map[string]interface{}{}
+	for key, id := range d.Get(cisDNSZoneRecordsRecordIDs).(map[string]interface{}) {
+		recordIDs[key] = id
+	}
+	for _, record := range deletes {
+		log.Printf("[INFO] Deleting DNS record %s (%s)", record.key(), record.ID)
+		_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(record.ID))
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			d.Set(cisDNSZoneRecordsRecordIDs, recordIDs)
+			return flex.FmtErrorf("[ERROR] Error deleting DNS record %s: %s %s", record.key(), err, response)
+		}
+		delete(recordIDs, record.key())
+	}
+	if len(deletes) > 0 {
+		deleted := make([]interface{}, 0, len(deletes))
+		for _, record := range deletes {
+			deleted = append(deleted, record.toMap())
+		}
+		d.Set(cisDNSZoneRecordsDeleted, deleted)
+	}
+
+	for _, record := range desired {
+		if existing, ok := liveByKey[record.key()]; ok {
+			recordIDs[record.key()] = existing.ID
+			if existing.TTL == record.TTL && existing.Priority == record.Priority {
+				continue
+			}
+			log.Printf("[INFO] Updating DNS record %s (%s)", record.key(), existing.ID)
+			opt := sess.NewUpdateDnsRecordOptions(existing.ID)
+			opt.SetName(record.Name)
+			opt.SetType(record.Type)
+			opt.SetContent(record.Content)
+			opt.SetTTL(int64(record.TTL))
+			if record.Type == cisDNSRecordTypeMX {
+				opt.SetPriority(int64(record.Priority))
+			}
+			if _, response, err := sess.UpdateDnsRecord(opt); err != nil {
+				d.Set(cisDNSZoneRecordsRecordIDs, recordIDs)
+				return flex.FmtErrorf("[ERROR] Error updating DNS record %s: %s %s", record.key(), err, response)
+			}
+			continue
+		}
+
+		log.Printf("[INFO] Creating DNS record %s", record.key())
+		opt := sess.NewCreateDnsRecordOptions()
+		opt.SetName(record.Name)
+		opt.SetType(record.Type)
+		opt.SetContent(record.Content)
+		opt.SetTTL(int64(record.TTL))
+		if record.Type == cisDNSRecordTypeMX {
+			opt.SetPriority(int64(record.Priority))
+		}
+		result, response, err := sess.CreateDnsRecord(opt)
+		if err != nil {
+			d.Set(cisDNSZoneRecordsRecordIDs, recordIDs)
+			return flex.FmtErrorf("[ERROR] Error creating DNS record %s: %s %s", record.key(), err, response)
+		}
+		recordIDs[record.key()] = *result.Result.ID
+	}
+
+	// Records that were managed but are missing from the zone are no longer managed.
+	for key := range recordIDs {
+		if _, ok := desiredByKey[key]; !ok {
+			delete(recordIDs, key)
+		}
+	}
+	d.Set(cisDNSZoneRecordsRecordIDs, recordIDs)
+	return resourceIBMCISDNSZoneRecordsRead(d, meta)
+}
+
+func resourceIBMCISDNSZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	live, err := listCISZoneRecords(sess)
+	if err != nil {
+		return err
+	}
+
+	// Without authoritative, only the records of the zone file or record list are reported. The
+	// record IDs never include the other records of the zone, so that they are not deleted with
+	// the resource.
+	managed := d.Get(cisDNSZoneRecordsRecordIDs).(map[string]interface{})
+	all := d.Get(cisDNSZoneRecordsAuthoritative).(bool)
+	records := make([]interface{}, 0, len(live))
+	recordIDs := map[string]interface{}{}
+	for _, record := range live {
+		_, ok := managed[record.key()]
+		if ok {
+			recordIDs[record.key()] = record.ID
+		}
+		if ok || all {
+			records = append(records, record.toMap())
+		}
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisDNSZoneRecordsRecords, records)
+	d.Set(cisDNSZoneRecordsRecordIDs, recordIDs)
+	return nil
+}
+
+func resourceIBMCISDNSZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	// Only the records of the zone file or record list are deleted, even in authoritative mode.
+	for key, id := range d.Get(cisDNSZoneRecordsRecordIDs).(map[string]interface{}) {
+		_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(id.(string)))
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return flex.FmtErrorf("[ERROR] Error deleting DNS record %s: %s %s", key, err, response)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// getCISZoneName returns the name of a CIS zone, which is the origin of the relative names of a
+// zone file.
+func getCISZoneName(meta interface{}, crn, zoneID string) (string, error) {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return "", err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	result, response, err := cisClient.GetZone(cisClient.NewGetZoneOptions(zoneID))
+	if err != nil {
+		return "", flex.FmtErrorf("[ERROR] Error reading zone %s: %s %s", zoneID, err, response)
+	}
+	return strings.ToLower(*result.Result.Name), nil
+}
+
+// listCISZoneRecords lists the records of the supported types in a zone.
+func listCISZoneRecords(sess *dnsrecordsv1.DnsRecordsV1) ([]cisZoneRecord, error) {
+	records := make([]cisZoneRecord, 0)
+	for page := int64(1); ; page++ {
+		opt := sess.NewListAllDnsRecordsOptions()
+		opt.SetPage(page)
+		opt.SetPerPage(1000)
+		result, response, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			return nil, flex.FmtErrorf("[ERROR] Error reading dns records: %s %s", err, response)
+		}
+		for _, r := range result.Result {
+			if !flex.StringContains(cisDNSZoneRecordsTypes, *r.Type) {
+				continue
+			}
+			record := cisZoneRecord{
+				ID:   *r.ID,
+				Name: strings.ToLower(*r.Name),
+				Type: *r.Type,
+			}
+			if r.Content != nil {
+				record.Content = normalizeCISZoneRecordContent(record.Type, *r.Content, "")
+			}
+			if r.TTL != nil {
+				record.TTL = int(*r.TTL)
+			}
+			if r.Priority != nil && record.Type == cisDNSRecordTypeMX {
+				record.Priority = int(*r.Priority)
+			}
+			records = append(records, record)
+		}
+		if result.ResultInfo == nil || result.ResultInfo.TotalCount == nil || page*1000 >= *result.ResultInfo.TotalCount {
+			break
+		}
+	}
+	return records, nil
+}
+
+// desiredCISZoneRecords returns the records of the zone_file or record arguments, sorted by key.
+func desiredCISZoneRecords(d interface{ Get(string) interface{} }, zoneName string) ([]cisZoneRecord, error) {
+	var records []cisZoneRecord
+	if zoneFile := d.Get(cisDNSZoneRecordsZoneFile).(string); zoneFile != "" {
+		parsed, err := parseCISZoneFile(zoneFile, zoneName)
+		if err != nil {
+			return nil, err
+		}
+		records = parsed
+	} else {
+		for _, r := range d.Get(cisDNSZoneRecordsRecord).(*schema.Set).List() {
+			m := r.(map[string]interface{})
+			record := cisZoneRecord{
+				Name:     qualifyCISZoneName(m[cisDNSRecordName].(string), zoneName),
+				Type:     m[cisDNSRecordType].(string),
+				TTL:      m[cisDNSRecordTTL].(int),
+				Priority: m[cisDNSRecordPriority].(int),
+			}
+			record.Content = normalizeCISZoneRecordContent(record.Type, m[cisDNSRecordContent].(string), zoneName)
+			if record.Type != cisDNSRecordTypeMX {
+				record.Priority = 0
+			}
+			records = append(records, record)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, record := range records {
+		if seen[record.key()] {
+			return nil, flex.FmtErrorf("[ERROR] Duplicate DNS record %s", record.key())
+		}
+		seen[record.key()] = true
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].key() < records[j].key() })
+	return records, nil
+}
+
+func cisZoneRecordFromMap(m map[string]interface{}) cisZoneRecord {
+	return cisZoneRecord{
+		Name:     m[cisDNSRecordName].(string),
+		Type:     m[cisDNSRecordType].(string),
+		Content:  m[cisDNSRecordContent].(string),
+		TTL:      m[cisDNSRecordTTL].(int),
+		Priority: m[cisDNSRecordPriority].(int),
+	}
+}
+
+// qualifyCISZoneName returns the fully qualified name, without the trailing dot, of a name of a
+// zone file. Names that do not end with a dot are relative to the origin.
+func qualifyCISZoneName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@" || name == "":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// normalizeCISZoneRecordContent returns the content of a record in the format returned by CIS.
+// The origin is empty for records read from CIS, whose names are already fully qualified.
+func normalizeCISZoneRecordContent(recordType, content, origin string) string {
+	content = strings.TrimSpace(content)
+	switch recordType {
+	case cisDNSRecordTypeCNAME, cisDNSRecordTypeMX, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+		return qualifyCISZoneName(content, origin)
+	case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+		return unquoteCISZoneText(content)
+	}
+	return content
+}
+
+// unquoteCISZoneText joins the character strings of a TXT record, such as "v=spf1 " "-all".
+func unquoteCISZoneText(content string) string {
+	if !strings.HasPrefix(content, "\"") {
+		return content
+	}
+	var text strings.Builder
+	inQuotes, escaped := false, false
+	for _, c := range content {
+		switch {
+		case escaped:
+			text.WriteRune(c)
+			escaped = false
+		case c == '\\' && inQuotes:
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+			text.WriteRune(c)
+		}
+	}
+	return text.String()
+}
+
+// parseCISZoneFile parses the records of a BIND zone file. SOA records are skipped because CIS
+// manages the SOA record of a zone.
+func parseCISZoneFile(content, zoneName string) ([]cisZoneRecord, error) {
+	origin := zoneName
+	defaultTTL := 1
+	owner := origin
+	records := make([]cisZoneRecord, 0)
+
+	lines, err := cisZoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		fields := cisZoneFileFields(line.text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) != 2 {
+				return nil, flex.FmtErrorf("[ERROR] Invalid $ORIGIN directive on line %d of the zone file", line.number)
+			}
+			origin = qualifyCISZoneName(fields[1], origin)
+			continue
+		case "$TTL":
+			if len(fields) != 2 {
+				return nil, flex.FmtErrorf("[ERROR] Invalid $TTL directive on line %d of the zone file", line.number)
+			}
+			ttl, err := parseCISZoneTTL(fields[1])
+			if err != nil {
+				return nil, flex.FmtErrorf("[ERROR] Invalid $TTL directive on line %d of the zone file: %s", line.number, err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, flex.FmtErrorf("[ERROR] The %s directive on line %d of the zone file is not supported", fields[0], line.number)
+		}
+
+		// A line that starts with a blank uses the owner name of the previous record.
+		if !line.continued {
+			owner = qualifyCISZoneName(fields[0], origin)
+			fields = fields[1:]
+		}
+
+		ttl := defaultTTL
+		for len(fields) > 0 {
+			if strings.EqualFold(fields[0], "IN") {
+				fields = fields[1:]
+				continue
+			}
+			if v, err := parseCISZoneTTL(fields[0]); err == nil {
+				ttl = v
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+		if len(fields) < 2 {
+			return nil, flex.FmtErrorf("[ERROR] Invalid record on line %d of the zone file", line.number)
+		}
+
+		recordType := strings.ToUpper(fields[0])
+		rdata := fields[1:]
+		if recordType == "SOA" {
+			continue
+		}
+		if !flex.StringContains(cisDNSZoneRecordsTypes, recordType) {
+			return nil, flex.FmtErrorf("[ERROR] The %s record on line %d of the zone file is not supported, supported types are %s. Use ibm_cis_dns_record for other record types", recordType, line.number, strings.Join(cisDNSZoneRecordsTypes, ", "))
+		}
+
+		record := cisZoneRecord{
+			Name: owner,
+			Type: recordType,
+			TTL:  ttl,
+		}
+		if recordType == cisDNSRecordTypeMX {
+			if len(rdata) != 2 {
+				return nil, flex.FmtErrorf("[ERROR] Invalid MX record on line %d of the zone file", line.number)
+			}
+			priority, err := strconv.Atoi(rdata[0])
+			if err != nil {
+				return nil, flex.FmtErrorf("[ERROR] Invalid MX priority on line %d of the zone file: %s", line.number, err)
+			}
+			record.Priority = priority
+			rdata = rdata[1:]
+		}
+		record.Content = normalizeCISZoneRecordContent(recordType, strings.Join(rdata, " "), origin)
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+type cisZoneFileLine struct {
+	number    int
+	text      string
+	continued bool
+}
+
+// cisZoneFileLines splits a zone file into logical lines, without comments, joining the lines
+// of records that span parentheses.
+func cisZoneFileLines(content string) ([]cisZoneFileLine, error) {
+	lines := make([]cisZoneFileLine, 0)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	var current *cisZoneFileLine
+	depth := 0
+	number := 0
+	for scanner.Scan() {
+		number++
+		raw := scanner.Text()
+		text := stripCISZoneComment(raw)
+		if current == nil {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			current = &cisZoneFileLine{
+				number:    number,
+				continued: len(raw) > 0 && unicode.IsSpace(rune(raw[0])),
+			}
+		}
+		for _, c := range text {
+			if c == '(' {
+				depth++
+			} else if c == ')' {
+				depth--
+			}
+		}
+		current.text += " " + strings.NewReplacer("(", " ", ")", " ").Replace(text)
+		if depth <= 0 {
+			lines = append(lines, *current)
+			current = nil
+			depth = 0
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if current != nil {
+		return nil, flex.FmtErrorf("[ERROR] Unbalanced parentheses on line %d of the zone file", current.number)
+	}
+	return lines, nil
+}
+
+// stripCISZoneComment removes the comment of a zone file line, ignoring semicolons in quotes.
+func stripCISZoneComment(line string) string {
+	inQuotes := false
+	for i, c := range line {
+		if c == '"' {
+			inQuotes = !inQuotes
+		} else if c == ';' && !inQuotes {
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// cisZoneFileFields splits a zone file line into fields, keeping quoted strings whole.
+func cisZoneFileFields(line string) []string {
+	fields := make([]string, 0)
+	var field strings.Builder
+	inQuotes := false
+	for _, c := range line {
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+			field.WriteRune(c)
+		case unicode.IsSpace(c) && !inQuotes:
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+		default:
+			field.WriteRune(c)
+		}
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// parseCISZoneTTL parses a time to live in seconds, or with the s, m, h, d and w units of BIND.
+func parseCISZoneTTL(value string) (int, error) {
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, nil
+	}
+	units := map[rune]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, number := 0, ""
+	for _, c := range strings.ToLower(value) {
+		if unicode.IsDigit(c) {
+			number += string(c)
+			continue
+		}
+		unit, ok := units[c]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid time to live %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		ttl += n * unit
+		number = ""
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid time to live %q", value)
+	}
+	return ttl, nil
+}
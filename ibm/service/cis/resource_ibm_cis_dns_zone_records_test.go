@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSZoneRecords_ZoneFile(t *testing.T) {
+	name := "ibm_cis_dns_zone_records.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDomainDataSourceConfigBasic1() + testAccCheckIBMCisDNSZoneRecordsConfigZoneFile("192.0.2.1", 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "3"),
+					resource.TestCheckResourceAttr(name, "record_ids.%", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "records.*", map[string]string{
+						"name":    fmt.Sprintf("tf-zone-records.%s", acc.CisDomainStatic),
+						"type":    "A",
+						"content": "192.0.2.1",
+						"ttl":     "300",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "records.*", map[string]string{
+						"type":     "MX",
+						"content":  fmt.Sprintf("mail.%s", acc.CisDomainStatic),
+						"priority": "10",
+					}),
+				),
+			},
+			{
+				// Changing the content replaces the record and changing the time to live updates it.
+				Config: testAccCheckIBMCisDomainDataSourceConfigBasic1() + testAccCheckIBMCisDNSZoneRecordsConfigZoneFile("192.0.2.2", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "records.*", map[string]string{
+						"type":    "A",
+						"content": "192.0.2.2",
+						"ttl":     "600",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckIBMCisDomainDataSourceConfigBasic1() + testAccCheckIBMCisDNSZoneRecordsConfigRecords(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "record_ids.%", "1"),
+					resource.TestCheckResourceAttr(name, "deleted_records.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSZoneRecordsConfigZoneFile(address string, ttl int) string {
+	return fmt.Sprintf(`
+resource "ibm_cis_dns_zone_records" "test" {
+  cis_id    = data.ibm_cis.cis.id
+  domain_id = data.ibm_cis_domain.cis_domain.id
+  zone_file = <<-EOT
+    $TTL 3600
+    tf-zone-records      %[2]d IN A     %[1]s
+    tf-zone-records-txt        IN TXT   "managed by terraform"
+    tf-zone-records-mx         IN MX    10 mail
+  EOT
+}
+`, address, ttl)
+}
+
+func testAccCheckIBMCisDNSZoneRecordsConfigRecords() string {
+	return `
+resource "ibm_cis_dns_zone_records" "test" {
+  cis_id    = data.ibm_cis.cis.id
+  domain_id = data.ibm_cis_domain.cis_domain.id
+  record {
+    name    = "tf-zone-records"
+    type    = "CNAME"
+    content = "www.example.com."
+    ttl     = 900
+  }
+}
+`
+}
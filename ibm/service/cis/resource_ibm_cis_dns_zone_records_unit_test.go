@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCISZoneFile(t *testing.T) {
+	zoneFile := `
+$ORIGIN example.com.
+$TTL 1h
+@       IN SOA ns1.example.com. admin.example.com. (
+            2024010101 ; serial
+            7200       ; refresh
+            3600 1209600 3600 )
+@            IN A     192.0.2.1
+             IN AAAA  2001:db8::1
+www     300  IN CNAME @
+mail    IN   600 MX   10 mx.example.net.
+txt          TXT      "v=spf1 " "include:example.net -all" ; comment
+Quoted       TXT      "a;b"
+$ORIGIN sub.example.com.
+host         A        192.0.2.2
+`
+	records, err := parseCISZoneFile(zoneFile, "ignored.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []cisZoneRecord{
+		{Name: "example.com", Type: "A", Content: "192.0.2.1", TTL: 3600},
+		{Name: "example.com", Type: "AAAA", Content: "2001:db8::1", TTL: 3600},
+		{Name: "www.example.com", Type: "CNAME", Content: "example.com", TTL: 300},
+		{Name: "mail.example.com", Type: "MX", Content: "mx.example.net", TTL: 600, Priority: 10},
+		{Name: "txt.example.com", Type: "TXT", Content: "v=spf1 include:example.net -all", TTL: 3600},
+		{Name: "quoted.example.com", Type: "TXT", Content: "a;b", TTL: 3600},
+		{Name: "host.sub.example.com", Type: "A", Content: "192.0.2.2", TTL: 3600},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("unexpected records:\n got: %+v\nwant: %+v", records, expected)
+	}
+}
+
+func TestParseCISZoneFileErrors(t *testing.T) {
+	for name, zoneFile := range map[string]string{
+		"unsupported type": "@ IN SRV 10 5 5060 sip.example.com.",
+		"include":          "$INCLUDE other.zone",
+		"parentheses":      "@ IN SOA ns1 admin ( 1 2 3",
+		"mx priority":      "@ IN MX ten mail",
+	} {
+		if _, err := parseCISZoneFile(zoneFile, "example.com"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestParseCISZoneTTL(t *testing.T) {
+	for value, expected := range map[string]int{"300": 300, "1h": 3600, "1h30m": 5400, "1w": 604800} {
+		ttl, err := parseCISZoneTTL(value)
+		if err != nil || ttl != expected {
+			t.Errorf("parseCISZoneTTL(%q) = %d, %v, want %d", value, ttl, err, expected)
+		}
+	}
+	if _, err := parseCISZoneTTL("A"); err == nil {
+		t.Error("expected an error for A")
+	}
+}
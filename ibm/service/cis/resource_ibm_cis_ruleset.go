@@ -239,9 +239,10 @@ var CISResourceResponseObject = &schema.Resource{
 						Description: "Description of the rulesets rule",
 					},
 					CISRulesetsRuleExpression: {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "Expression of the rulesets rule",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateCISRulesetExpression,
+						Description:  "Expression of the rulesets rule",
 					},
 					CISRulesetsRuleRef: {
 						Type:        schema.TypeString,
@@ -291,11 +292,12 @@ var CISResourceResponseObject = &schema.Resource{
 
 func ResourceIBMCISRuleset() *schema.Resource {
 	return &schema.Resource{
-		Read:     ResourceIBMCISRulesetRead,
-		Update:   ResourceIBMCISRulesetUpdate,
-		Delete:   ResourceIBMCISRulesetDelete,
-		Create:   ResourceIBMCISRulesetRead,
-		Importer: &schema.ResourceImporter{},
+		Read:          ResourceIBMCISRulesetRead,
+		Update:        ResourceIBMCISRulesetUpdate,
+		Delete:        ResourceIBMCISRulesetDelete,
+		Create:        ResourceIBMCISRulesetRead,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCISRulesetCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -13,11 +13,12 @@ import (
 
 func ResourceIBMCISRulesetEntryPointVersion() *schema.Resource {
 	return &schema.Resource{
-		Read:     ResourceIBMCISRulesetEntryPointVersionRead,
-		Create:   ResourceIBMCISRulesetEntryPointVersionUpdate,
-		Update:   ResourceIBMCISRulesetEntryPointVersionUpdate,
-		Delete:   ResourceIBMCISRulesetEntryPointVersionDelete,
-		Importer: &schema.ResourceImporter{},
+		Read:          ResourceIBMCISRulesetEntryPointVersionRead,
+		Create:        ResourceIBMCISRulesetEntryPointVersionUpdate,
+		Update:        ResourceIBMCISRulesetEntryPointVersionUpdate,
+		Delete:        ResourceIBMCISRulesetEntryPointVersionDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCISRulesetEntryPointVersionCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
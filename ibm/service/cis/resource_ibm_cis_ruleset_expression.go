@@ -0,0 +1,637 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The rules language of CIS rulesets is the Cloudflare Rules language. The expressions are parsed at
+// plan time so that unknown operators, unterminated strings and unbalanced parentheses are reported
+// before the API rejects the ruleset at apply. New fields and functions are added to the language
+// regularly, so the names that are not known here are only reported as warnings.
+
+// cisRulesetFields are the fields that can be used in a ruleset expression.
+var cisRulesetFields = map[string]bool{
+	"http.cookie":                                          true,
+	"http.host":                                            true,
+	"http.referer":                                         true,
+	"http.user_agent":                                      true,
+	"http.x_forwarded_for":                                 true,
+	"http.request.full_uri":                                true,
+	"http.request.method":                                  true,
+	"http.request.version":                                 true,
+	"http.request.cookies":                                 true,
+	"http.request.timestamp.sec":                           true,
+	"http.request.timestamp.msec":                          true,
+	"http.request.uri":                                     true,
+	"http.request.uri.path":                                true,
+	"http.request.uri.path.extension":                      true,
+	"http.request.uri.query":                               true,
+	"http.request.uri.args":                                true,
+	"http.request.uri.args.names":                          true,
+	"http.request.uri.args.values":                         true,
+	"http.request.headers":                                 true,
+	"http.request.headers.names":                           true,
+	"http.request.headers.values":                          true,
+	"http.request.headers.truncated":                       true,
+	"http.request.accepted_languages":                      true,
+	"http.request.body.raw":                                true,
+	"http.request.body.size":                               true,
+	"http.request.body.truncated":                          true,
+	"http.request.body.mime":                               true,
+	"http.request.body.form":                               true,
+	"http.request.body.form.names":                         true,
+	"http.request.body.form.values":                        true,
+	"http.response.code":                                   true,
+	"http.response.headers":                                true,
+	"http.response.headers.names":                          true,
+	"http.response.headers.values":                         true,
+	"http.response.content_type.media_type":                true,
+	"raw.http.request.full_uri":                            true,
+	"raw.http.request.uri":                                 true,
+	"raw.http.request.uri.path":                            true,
+	"raw.http.request.uri.path.extension":                  true,
+	"raw.http.request.uri.query":                           true,
+	"raw.http.request.uri.args":                            true,
+	"raw.http.request.uri.args.names":                      true,
+	"raw.http.request.uri.args.values":                     true,
+	"ip.src":                                               true,
+	"ip.src.lat":                                           true,
+	"ip.src.lon":                                           true,
+	"ip.src.city":                                          true,
+	"ip.src.postal_code":                                   true,
+	"ip.src.metro_code":                                    true,
+	"ip.src.region":                                        true,
+	"ip.src.region_code":                                   true,
+	"ip.src.timezone.name":                                 true,
+	"ip.src.country":                                       true,
+	"ip.src.continent":                                     true,
+	"ip.src.asnum":                                         true,
+	"ip.src.is_in_european_union":                          true,
+	"ip.src.subdivision_1_iso_code":                        true,
+	"ip.src.subdivision_2_iso_code":                        true,
+	"ip.geoip.asnum":                                       true,
+	"ip.geoip.continent":                                   true,
+	"ip.geoip.country":                                     true,
+	"ip.geoip.is_in_european_union":                        true,
+	"ip.geoip.subdivision_1_iso_code":                      true,
+	"ip.geoip.subdivision_2_iso_code":                      true,
+	"ssl":                                                  true,
+	"cf.threat_score":                                      true,
+	"cf.client.bot":                                        true,
+	"cf.verified_bot_category":                             true,
+	"cf.edge.server_ip":                                    true,
+	"cf.edge.server_port":                                  true,
+	"cf.hostname.metadata":                                 true,
+	"cf.worker.upstream_zone":                              true,
+	"cf.random_seed":                                       true,
+	"cf.ray_id":                                            true,
+	"cf.colo.name":                                         true,
+	"cf.colo.region":                                       true,
+	"cf.response.1xxx_code":                                true,
+	"cf.response.error_type":                               true,
+	"cf.waf.score":                                         true,
+	"cf.waf.score.sqli":                                    true,
+	"cf.waf.score.xss":                                     true,
+	"cf.waf.score.rce":                                     true,
+	"cf.waf.score.class":                                   true,
+	"cf.waf.auth_detected":                                 true,
+	"cf.waf.credential_check.password_leaked":              true,
+	"cf.waf.credential_check.username_leaked":              true,
+	"cf.waf.credential_check.username_password_leaked":     true,
+	"cf.waf.credential_check.username_and_password_leaked": true,
+	"cf.waf.credential_check.username_password_similar":    true,
+	"cf.waf.content_scan.has_obj":                          true,
+	"cf.waf.content_scan.has_malicious_obj":                true,
+	"cf.waf.content_scan.has_failed":                       true,
+	"cf.waf.content_scan.num_obj":                          true,
+	"cf.waf.content_scan.num_malicious_obj":                true,
+	"cf.waf.content_scan.obj_sizes":                        true,
+	"cf.waf.content_scan.obj_types":                        true,
+	"cf.waf.content_scan.obj_results":                      true,
+}
+
+// cisRulesetFieldPrefixes are the families of fields whose names are not fixed, such as the claims of
+// a JSON web token or the scores of bot management.
+var cisRulesetFieldPrefixes = []string{
+	"cf.bot_management.",
+	"cf.tls_client_auth.",
+	"cf.api_gateway.",
+	"cf.llm.",
+	"cf.timings.",
+	"http.request.jwt.",
+	"http.request.body.multipart.",
+}
+
+// cisRulesetFunctions are the functions that can be used in a ruleset expression.
+var cisRulesetFunctions = map[string]bool{
+	"all":                    true,
+	"any":                    true,
+	"bit_slice":              true,
+	"cidr":                   true,
+	"cidr6":                  true,
+	"concat":                 true,
+	"decode_base64":          true,
+	"ends_with":              true,
+	"has_key":                true,
+	"has_value":              true,
+	"is_timed_hmac_valid_v0": true,
+	"join":                   true,
+	"len":                    true,
+	"lookup_json_integer":    true,
+	"lookup_json_string":     true,
+	"lower":                  true,
+	"regex_replace":          true,
+	"remove_bytes":           true,
+	"remove_query_args":      true,
+	"sha256":                 true,
+	"split":                  true,
+	"starts_with":            true,
+	"substring":              true,
+	"to_string":              true,
+	"upper":                  true,
+	"url_decode":             true,
+	"uuidv4":                 true,
+	"wildcard_replace":       true,
+	"encode_base64":          true,
+}
+
+// cisRulesetComparisonOperators are the comparison operators, in their English and C-like notations.
+var cisRulesetComparisonOperators = map[string]bool{
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+	"contains": true, "matches": true, "~": true, "in": true, "wildcard": true, "strict": true,
+}
+
+// cisRulesetPhaseActions are the actions that can be used by the rules of each ruleset phase.
+var cisRulesetPhaseActions = map[string][]string{
+	"ddos_l7":                         {"execute"},
+	"http_config_settings":            {"set_config"},
+	"http_custom_errors":              {"serve_error"},
+	"http_log_custom_fields":          {"log_custom_field"},
+	"http_ratelimit":                  {"block", "challenge", "js_challenge", "log", "managed_challenge"},
+	"http_request_cache_settings":     {"set_cache_settings"},
+	"http_request_dynamic_redirect":   {"redirect"},
+	"http_request_firewall_custom":    {"block", "challenge", "js_challenge", "log", "managed_challenge", "skip"},
+	"http_request_firewall_managed":   {"block", "challenge", "execute", "js_challenge", "log", "managed_challenge", "skip"},
+	"http_request_late_transform":     {"rewrite"},
+	"http_request_origin":             {"route"},
+	"http_request_redirect":           {"redirect"},
+	"http_request_sbfm":               {"block", "challenge", "js_challenge", "log", "managed_challenge", "skip"},
+	"http_request_transform":          {"rewrite"},
+	"http_response_compression":       {"compress_response"},
+	"http_response_firewall_managed":  {"execute", "log", "skip"},
+	"http_response_headers_transform": {"rewrite"},
+}
+
+type cisExpressionTokenKind int
+
+const (
+	cisTokenWord cisExpressionTokenKind = iota
+	cisTokenString
+	cisTokenList
+	cisTokenPunct
+	cisTokenOperator
+)
+
+type cisExpressionToken struct {
+	kind  cisExpressionTokenKind
+	value string
+	pos   int
+}
+
+// tokenizeCISRulesetExpression splits an expression in words, strings, list references, punctuation
+// and symbolic operators.
+func tokenizeCISRulesetExpression(expr string) ([]cisExpressionToken, error) {
+	tokens := make([]cisExpressionToken, 0)
+	isWordChar := func(r byte) bool {
+		return r == '_' || r == '.' || r == ':' || r == '/' || r == '-' || unicode.IsLetter(rune(r)) || unicode.IsDigit(rune(r))
+	}
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			start := i
+			i++
+			for i < len(expr) && expr[i] != '"' {
+				if expr[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, cisExpressionToken{cisTokenString, expr[start:i], start})
+		case c == 'r' && i+1 < len(expr) && (expr[i+1] == '"' || expr[i+1] == '#'):
+			// Raw strings r"..." and r#"..."#, where the number of # is not limited.
+			start := i
+			j := i + 1
+			for j < len(expr) && expr[j] == '#' {
+				j++
+			}
+			if j >= len(expr) || expr[j] != '"' {
+				return nil, fmt.Errorf("invalid raw string at position %d", start)
+			}
+			terminator := "\"" + expr[i+1:j]
+			end := strings.Index(expr[j+1:], terminator)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i = j + 1 + end + len(terminator)
+			tokens = append(tokens, cisExpressionToken{cisTokenString, expr[start:i], start})
+		case c == '$':
+			start := i
+			i++
+			for i < len(expr) && isWordChar(expr[i]) {
+				i++
+			}
+			if i == start+1 {
+				return nil, fmt.Errorf("missing list name at position %d", start)
+			}
+			tokens = append(tokens, cisExpressionToken{cisTokenList, expr[start:i], start})
+		case strings.ContainsRune("(){}[],*", rune(c)):
+			tokens = append(tokens, cisExpressionToken{cisTokenPunct, string(c), i})
+			i++
+		case strings.ContainsRune("=!<>~&|^", rune(c)):
+			start := i
+			for i < len(expr) && strings.ContainsRune("=!<>~&|^", rune(expr[i])) {
+				i++
+			}
+			op := expr[start:i]
+			switch op {
+			case "==", "!=", "<", "<=", ">", ">=", "~", "&&", "||", "^^", "!":
+			default:
+				return nil, fmt.Errorf("unknown operator %q at position %d", op, start)
+			}
+			tokens = append(tokens, cisExpressionToken{cisTokenOperator, op, start})
+		case isWordChar(c):
+			start := i
+			for i < len(expr) && isWordChar(expr[i]) {
+				i++
+			}
+			tokens = append(tokens, cisExpressionToken{cisTokenWord, expr[start:i], start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return tokens, nil
+}
+
+type cisExpressionParser struct {
+	tokens   []cisExpressionToken
+	pos      int
+	warnings []string
+}
+
+func (p *cisExpressionParser) peek() *cisExpressionToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *cisExpressionParser) next() *cisExpressionToken {
+	t := p.peek()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+// is reports whether the next token is one of the given values.
+func (p *cisExpressionParser) is(values ...string) bool {
+	t := p.peek()
+	if t == nil || t.kind == cisTokenString || t.kind == cisTokenList {
+		return false
+	}
+	for _, v := range values {
+		if t.value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *cisExpressionParser) expect(value string) error {
+	t := p.next()
+	if t == nil {
+		return fmt.Errorf("expected %q at the end of the expression", value)
+	}
+	if t.value != value || t.kind == cisTokenString {
+		return fmt.Errorf("expected %q but found %q at position %d", value, t.value, t.pos)
+	}
+	return nil
+}
+
+func (p *cisExpressionParser) unexpected(t *cisExpressionToken) error {
+	if t == nil {
+		return fmt.Errorf("unexpected end of the expression")
+	}
+	return fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+}
+
+func (p *cisExpressionParser) parseOr() error {
+	if err := p.parseXor(); err != nil {
+		return err
+	}
+	for p.is("or", "||") {
+		p.next()
+		if err := p.parseXor(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cisExpressionParser) parseXor() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.is("xor", "^^") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cisExpressionParser) parseAnd() error {
+	if err := p.parseNot(); err != nil {
+		return err
+	}
+	for p.is("and", "&&") {
+		p.next()
+		if err := p.parseNot(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cisExpressionParser) parseNot() error {
+	if p.is("not", "!") {
+		p.next()
+		return p.parseNot()
+	}
+	if p.is("(") {
+		p.next()
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		return p.expect(")")
+	}
+	return p.parseComparison()
+}
+
+// parseComparison parses a field or a function call, optionally compared to a value. A field or a
+// function call that is not compared must be a boolean.
+func (p *cisExpressionParser) parseComparison() error {
+	t := p.peek()
+	if t != nil && t.kind == cisTokenWord && (t.value == "true" || t.value == "false") {
+		// The expression true matches all the requests.
+		p.next()
+		return nil
+	}
+	if t == nil || t.kind != cisTokenWord || isCISRulesetLiteral(t.value) {
+		return fmt.Errorf("expected a field or a function, %s", p.unexpected(t))
+	}
+	if err := p.parseValue(); err != nil {
+		return err
+	}
+	op := p.peek()
+	if op == nil || op.kind == cisTokenString || !cisRulesetComparisonOperators[op.value] {
+		return nil
+	}
+	p.next()
+	switch op.value {
+	case "strict":
+		if err := p.expect("wildcard"); err != nil {
+			return err
+		}
+	case "in":
+		if t := p.peek(); t != nil && t.kind == cisTokenList {
+			p.next()
+			return nil
+		}
+		return p.parseSet()
+	}
+	return p.parseValue()
+}
+
+// parseSet parses a set of values such as {"GET" "HEAD"} or {192.0.2.0/24 198.51.100.1}.
+func (p *cisExpressionParser) parseSet() error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	count := 0
+	for !p.is("}") {
+		t := p.next()
+		if t == nil {
+			return fmt.Errorf("expected \"}\" at the end of the expression")
+		}
+		if t.kind != cisTokenString && (t.kind != cisTokenWord || !isCISRulesetLiteral(t.value)) {
+			return fmt.Errorf("expected a value in the set, %s", p.unexpected(t))
+		}
+		count++
+	}
+	p.next()
+	if count == 0 {
+		return fmt.Errorf("empty set at position %d", p.tokens[p.pos-1].pos)
+	}
+	return nil
+}
+
+// parseValue parses a literal, a field or a function call, followed by any number of indexes.
+func (p *cisExpressionParser) parseValue() error {
+	t := p.next()
+	if t == nil {
+		return p.unexpected(t)
+	}
+	switch {
+	case t.kind == cisTokenString:
+		return nil
+	case t.kind != cisTokenWord:
+		return fmt.Errorf("expected a value, %s", p.unexpected(t))
+	case isCISRulesetLiteral(t.value):
+		return nil
+	case p.is("("):
+		if !cisRulesetFunctions[t.value] {
+			p.warnings = append(p.warnings, fmt.Sprintf("unknown function %q at position %d", t.value, t.pos))
+		}
+		p.next()
+		if !p.is(")") {
+			for {
+				if err := p.parseArgument(); err != nil {
+					return err
+				}
+				if !p.is(",") {
+					break
+				}
+				p.next()
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return err
+		}
+	default:
+		if !isCISRulesetField(t.value) {
+			p.warnings = append(p.warnings, fmt.Sprintf("unknown field %q at position %d", t.value, t.pos))
+		}
+	}
+	for p.is("[") {
+		p.next()
+		index := p.next()
+		if index == nil || (index.kind != cisTokenString && index.value != "*" && (index.kind != cisTokenWord || !isCISRulesetLiteral(index.value))) {
+			return fmt.Errorf("expected an index, %s", p.unexpected(index))
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseArgument parses an argument of a function, which is a literal or an expression, as in
+// any(http.request.headers.values[*] contains "bot").
+func (p *cisExpressionParser) parseArgument() error {
+	if t := p.peek(); t != nil && (t.kind == cisTokenString || (t.kind == cisTokenWord && isCISRulesetLiteral(t.value))) {
+		p.next()
+		return nil
+	}
+	return p.parseOr()
+}
+
+// isCISRulesetLiteral reports whether a word is a number, an IP address or range, or a boolean.
+func isCISRulesetLiteral(word string) bool {
+	if word == "true" || word == "false" {
+		return true
+	}
+	if unicode.IsDigit(rune(word[0])) || (word[0] == '-' && len(word) > 1 && unicode.IsDigit(rune(word[1]))) {
+		return true
+	}
+	// IPv6 addresses may start with a letter, but fields never contain a colon.
+	return strings.Contains(word, ":")
+}
+
+func isCISRulesetField(name string) bool {
+	if cisRulesetFields[name] {
+		return true
+	}
+	for _, prefix := range cisRulesetFieldPrefixes {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// lintCISRulesetExpression checks the syntax of a ruleset expression and its operators. The fields
+// and functions that are not known are returned as warnings.
+func lintCISRulesetExpression(expr string) ([]string, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+	tokens, err := tokenizeCISRulesetExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &cisExpressionParser{tokens: tokens}
+	if err := p.parseOr(); err != nil {
+		return p.warnings, err
+	}
+	if t := p.peek(); t != nil {
+		if t.value == ")" {
+			return p.warnings, fmt.Errorf("unbalanced \")\" at position %d", t.pos)
+		}
+		return p.warnings, p.unexpected(t)
+	}
+	return p.warnings, nil
+}
+
+// validateCISRulesetExpression is the ValidateFunc of the ruleset expression arguments.
+func validateCISRulesetExpression(v interface{}, k string) (ws []string, errors []error) {
+	warnings, err := lintCISRulesetExpression(v.(string))
+	for _, w := range warnings {
+		ws = append(ws, fmt.Sprintf("%q may not be a valid ruleset expression: %s", k, w))
+	}
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid ruleset expression: %s", k, err))
+	}
+	return
+}
+
+// checkCISRulesetRuleActions returns an error that lists the actions of the rules that can't be
+// used in the phase of the ruleset. When the phase is not known, the actions are not checked.
+func checkCISRulesetRuleActions(phase string, rules []interface{}) error {
+	allowed, knownPhase := cisRulesetPhaseActions[phase]
+	if !knownPhase {
+		return nil
+	}
+	unsupported := make([]string, 0)
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		action, _ := rule[CISRulesetsRuleAction].(string)
+		if action == "" {
+			continue
+		}
+		supported := false
+		for _, a := range allowed {
+			if a == action {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			unsupported = append(unsupported, fmt.Sprintf("%q of rule %d", action, i))
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("[ERROR] The actions %s are not supported in the %s phase, the supported actions are %s", strings.Join(unsupported, ", "), phase, strings.Join(allowed, ", "))
+	}
+	return nil
+}
+
+func resourceIBMCISRulesetCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, r := range diff.Get(CISRulesetsObjectOutput).([]interface{}) {
+		ruleset, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		phase, _ := ruleset[CISRulesetsPhase].(string)
+		rules, _ := ruleset[CISRulesetsRules].([]interface{})
+		if err := checkCISRulesetRuleActions(phase, rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceIBMCISRulesetEntryPointVersionCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	phase := diff.Get(CISRulesetPhase).(string)
+	for _, r := range diff.Get(CISRulesetsEntryPointOutput).(*schema.Set).List() {
+		ruleset, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules, _ := ruleset[CISRulesetsRules].([]interface{})
+		if err := checkCISRulesetRuleActions(phase, rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
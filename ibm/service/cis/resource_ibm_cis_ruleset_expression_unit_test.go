@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/rulesetsv1"
+)
+
+func TestLintCISRulesetExpression(t *testing.T) {
+	valid := []string{
+		`(http.request.uri.path eq "/login" and ip.src.country ne "US")`,
+		`http.host == "example.com" && not ssl`,
+		`ip.src in {192.0.2.0/24 2001:db8::/32 198.51.100.1}`,
+		`ip.src in $office_ips`,
+		`http.request.method in {"GET" "HEAD"}`,
+		`http.request.headers["user-agent"][0] contains "curl"`,
+		`any(http.request.headers.values[*] contains "bot")`,
+		`starts_with(lower(http.request.uri.path), "/api/") or http.request.uri.path matches r"^/v[0-9]+/"`,
+		`http.request.full_uri strict wildcard "https://example.com/*"`,
+		`cf.bot_management.score lt 30 and not cf.bot_management.verified_bot`,
+		`http.request.uri.query ~ "id=[0-9]+" xor cf.threat_score gt 10`,
+		`true`,
+		``,
+	}
+	for _, expr := range valid {
+		warnings, err := lintCISRulesetExpression(expr)
+		if err != nil {
+			t.Errorf("expected %q to be valid, got %s", expr, err)
+		}
+		if len(warnings) > 0 {
+			t.Errorf("expected no warnings for %q, got %v", expr, warnings)
+		}
+	}
+
+	// Fields and functions that are not known are only warnings.
+	warned := map[string]string{
+		`cf.zone.name eq "example.com" and cf.zone.plan eq "ENT"`: `unknown field "cf.zone.name"`,
+		`cf.metal.id eq 1`:                      `unknown field "cf.metal.id"`,
+		`cf.edge.l4.delta_duration gt 10`:       `unknown field "cf.edge.l4.delta_duration"`,
+		`http.hots eq "example.com"`:            `unknown field "http.hots"`,
+		`lowercase(http.host) eq "example.com"`: `unknown function "lowercase"`,
+	}
+	for expr, message := range warned {
+		warnings, err := lintCISRulesetExpression(expr)
+		if err != nil {
+			t.Errorf("expected %q to be valid, got %s", expr, err)
+		}
+		if len(warnings) == 0 || !strings.Contains(warnings[0], message) {
+			t.Errorf("expected the warnings of %q to contain %q, got %v", expr, message, warnings)
+		}
+	}
+
+	invalid := map[string]string{
+		`(http.host eq "example.com"`:              `expected ")"`,
+		`http.host eq "example.com")`:              `unbalanced ")"`,
+		`http.host equals "example.com"`:           `unexpected "equals"`,
+		`http.host eq "example.com" and`:           `unexpected end`,
+		`http.host eq "example.com`:                `unterminated string`,
+		`http.host === "example.com"`:              `unknown operator "==="`,
+		`ip.src in {}`:                             `empty set`,
+		`http.request.method in {"GET" http.host}`: `expected a value in the set`,
+		`"example.com" eq http.host`:               `expected a field or a function`,
+	}
+	for expr, message := range invalid {
+		_, err := lintCISRulesetExpression(expr)
+		if err == nil {
+			t.Errorf("expected %q to be invalid", expr)
+			continue
+		}
+		if !strings.Contains(err.Error(), message) {
+			t.Errorf("expected the error of %q to contain %q, got %s", expr, message, err)
+		}
+	}
+}
+
+func TestCheckCISRulesetRuleActions(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{CISRulesetsRuleAction: "block"},
+		map[string]interface{}{CISRulesetsRuleAction: "managed_challenge"},
+	}
+	if err := checkCISRulesetRuleActions("http_request_firewall_custom", rules); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := checkCISRulesetRuleActions("http_request_transform", rules); err == nil || !strings.Contains(err.Error(), `"block" of rule 0, "managed_challenge" of rule 1 are not supported in the http_request_transform phase`) {
+		t.Errorf("expected an unsupported action error, got %v", err)
+	}
+	if err := checkCISRulesetRuleActions("", []interface{}{map[string]interface{}{CISRulesetsRuleAction: "a_future_action"}}); err != nil {
+		t.Errorf("unexpected error without a phase: %s", err)
+	}
+	if err := checkCISRulesetRuleActions("a_future_phase", rules); err != nil {
+		t.Errorf("unexpected error for an unknown phase: %s", err)
+	}
+}
+
+func TestRenderCISRulesetsHCL(t *testing.T) {
+	rulesets := []rulesetsv1.RulesetDetails{{
+		Name:  core.StringPtr("default"),
+		Phase: core.StringPtr("http_request_firewall_custom"),
+		Rules: []rulesetsv1.RuleDetails{{
+			ID:          core.StringPtr("rule-1"),
+			Action:      core.StringPtr("skip"),
+			Expression:  core.StringPtr(`http.request.uri.path eq "/${var}"`),
+			Enabled:     core.BoolPtr(true),
+			Description: core.StringPtr(""),
+			ActionParameters: &rulesetsv1.ActionParameters{
+				Ruleset: core.StringPtr("current"),
+			},
+		}},
+	}}
+	expected := `resource "ibm_cis_ruleset_entrypoint_version" "http_request_firewall_custom" {
+  cis_id = "crn"
+  domain_id = "zone"
+  phase = "http_request_firewall_custom"
+
+  rulesets {
+    name = "default"
+
+    rules {
+      action = "skip"
+      expression = "http.request.uri.path eq \"/$${var}\""
+      enabled = true
+      action_parameters {
+        ruleset = "current"
+      }
+    }
+  }
+}
+`
+	if actual := renderCISRulesetsHCL("crn", "zone", rulesets); actual != expected {
+		t.Errorf("unexpected HCL:\n%s", actual)
+	}
+}
@@ -220,9 +220,10 @@ var CISRulesetsRulesObject = &schema.Resource{
 			Description: "Description of the rulesets rule",
 		},
 		CISRulesetsRuleExpression: {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Expression of the rulesets rule",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateCISRulesetExpression,
+			Description:  "Expression of the rulesets rule",
 		},
 		CISRulesetsRuleRef: {
 			Type:        schema.TypeString,
@@ -273,9 +274,10 @@ var CISRulesetsRulesObject = &schema.Resource{
 						Elem:        &schema.Schema{Type: schema.TypeString},
 					},
 					CISRulesetsRuleRateLimitCountingExpression: {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "Counting expression of the ratelimit on rulesets rule.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateCISRulesetExpression,
+						Description:  "Counting expression of the ratelimit on rulesets rule.",
 					},
 					CISRulesetsRuleRateLimitMitigationTimeout: {
 						Type:        schema.TypeInt,
@@ -0,0 +1,315 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &cosPresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &cosPresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &cosPresignedURLEphemeralResource{}
+)
+
+// COS accepts any signing region for SigV4, the one used by the COS documentation is kept.
+const cosPresignRegion = "us-standard"
+
+// SigV4 presigned URLs are valid for at most 7 days.
+const cosPresignMaxExpiration = 7 * 24 * 60 * 60
+
+func NewCOSPresignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &cosPresignedURLEphemeralResource{}
+}
+
+type cosPresignedURLEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type cosPresignedURLModel struct {
+	BucketName      types.String `tfsdk:"bucket_name"`
+	BucketLocation  types.String `tfsdk:"bucket_location"`
+	EndpointType    types.String `tfsdk:"endpoint_type"`
+	Key             types.String `tfsdk:"key"`
+	Method          types.String `tfsdk:"method"`
+	Expiration      types.Int64  `tfsdk:"expiration"`
+	ResourceKeyID   types.String `tfsdk:"resource_key_id"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	URL             types.String `tfsdk:"url"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+}
+
+func (r *cosPresignedURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_cos_presigned_url"
+}
+
+func (r *cosPresignedURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a time-limited presigned URL to download or upload a COS object. The URL is signed locally with HMAC credentials and is never stored in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the COS bucket.",
+			},
+			"bucket_location": schema.StringAttribute{
+				Required:    true,
+				Description: "COS bucket location.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "COS endpoint type: public, private, direct. Default: public",
+			},
+			"key": schema.StringAttribute{
+				Required:    true,
+				Description: "Key of the COS object.",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "HTTP method allowed by the URL: GET to download the object, PUT to upload it. Default: GET",
+			},
+			"expiration": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds for which the URL is valid, at most 604800 (7 days). Default: 3600",
+			},
+			"resource_key_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of a resource key of the COS instance that was created with HMAC credentials. Conflicts with access_key_id and secret_access_key.",
+			},
+			"access_key_id": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "HMAC access key ID. Required with secret_access_key when resource_key_id is not set.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "HMAC secret access key. Required with access_key_id when resource_key_id is not set.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time at which the URL expires, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func (r *cosPresignedURLEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config cosPresignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.EndpointType.IsNull() && !config.EndpointType.IsUnknown() {
+		if endpointType := config.EndpointType.ValueString(); endpointType != "public" && endpointType != "private" && endpointType != "direct" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint_type"),
+				"Invalid Endpoint Type",
+				fmt.Sprintf("endpoint_type must be public, private or direct, got '%s'.", endpointType),
+			)
+		}
+	}
+	if !config.Method.IsNull() && !config.Method.IsUnknown() {
+		if method := config.Method.ValueString(); method != "GET" && method != "PUT" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("method"),
+				"Invalid Method",
+				fmt.Sprintf("method must be GET or PUT, got '%s'.", method),
+			)
+		}
+	}
+	if !config.Expiration.IsNull() && !config.Expiration.IsUnknown() {
+		if expiration := config.Expiration.ValueInt64(); expiration < 1 || expiration > cosPresignMaxExpiration {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration"),
+				"Invalid Expiration",
+				fmt.Sprintf("expiration must be between 1 and %d seconds, got %d.", cosPresignMaxExpiration, expiration),
+			)
+		}
+	}
+
+	if config.ResourceKeyID.IsUnknown() || config.AccessKeyID.IsUnknown() || config.SecretAccessKey.IsUnknown() {
+		return
+	}
+	hasResourceKey := !config.ResourceKeyID.IsNull()
+	hasAccessKey := !config.AccessKeyID.IsNull()
+	hasSecretKey := !config.SecretAccessKey.IsNull()
+	switch {
+	case hasResourceKey && (hasAccessKey || hasSecretKey):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource_key_id"),
+			"Conflicting HMAC Credentials",
+			"resource_key_id cannot be set together with access_key_id or secret_access_key.",
+		)
+	case !hasResourceKey && (!hasAccessKey || !hasSecretKey):
+		resp.Diagnostics.AddError(
+			"Missing HMAC Credentials",
+			"Either resource_key_id, or both access_key_id and secret_access_key must be set.",
+		)
+	}
+}
+
+func (r *cosPresignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientSession, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+	r.session = clientSession
+}
+
+func (r *cosPresignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config cosPresignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := config.BucketName.ValueString()
+	bucketLocation := config.BucketLocation.ValueString()
+	objectKey := config.Key.ValueString()
+	endpointType := "public"
+	if !config.EndpointType.IsNull() {
+		endpointType = config.EndpointType.ValueString()
+	}
+	method := "GET"
+	if !config.Method.IsNull() {
+		method = config.Method.ValueString()
+	}
+	expiration := int64(3600)
+	if !config.Expiration.IsNull() {
+		expiration = config.Expiration.ValueInt64()
+	}
+
+	accessKeyID := config.AccessKeyID.ValueString()
+	secretAccessKey := config.SecretAccessKey.ValueString()
+	if !config.ResourceKeyID.IsNull() {
+		var err error
+		accessKeyID, secretAccessKey, err = r.resourceKeyHMACCredentials(config.ResourceKeyID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resource_key_id"),
+				"Failed to Get HMAC Credentials",
+				fmt.Sprintf("Failed to get the HMAC credentials of resource key '%s': %s", config.ResourceKeyID.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	bxSession, err := r.session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create COS Client", err.Error())
+		return
+	}
+	visibility := endpointType
+	if endpointType == "direct" {
+		visibility = "private"
+	}
+	apiEndpoint := getCosEndpoint(bucketLocation, endpointType)
+	apiEndpoint = conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
+	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	if apiEndpoint == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Create COS Client",
+			fmt.Sprintf("The endpoint doesn't exist for location %s and endpoint type %s.", bucketLocation, endpointType),
+		)
+		return
+	}
+
+	validity := time.Duration(expiration) * time.Second
+	presignedURL, err := cosPresignURL(apiEndpoint, accessKeyID, secretAccessKey, bucketName, objectKey, method, validity)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Presign URL",
+			fmt.Sprintf("Failed to presign the %s URL of object '%s' in COS bucket '%s': %s", method, objectKey, bucketName, err.Error()),
+		)
+		return
+	}
+
+	config.URL = types.StringValue(presignedURL)
+	config.ExpiresAt = types.StringValue(time.Now().Add(validity).UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// cosPresignURL signs a path style SigV4 URL for a GET or PUT of the object with static HMAC credentials.
+func cosPresignURL(endpoint, accessKeyID, secretAccessKey, bucketName, objectKey, method string, validity time.Duration) (string, error) {
+	s3Conf := aws.NewConfig().
+		WithEndpoint(endpoint).
+		WithRegion(cosPresignRegion).
+		WithCredentials(credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")).
+		WithS3ForcePathStyle(true)
+	s3Client := s3.New(session.Must(session.NewSession()), s3Conf)
+
+	var presignReq *request.Request
+	if method == "PUT" {
+		presignReq, _ = s3Client.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+	} else {
+		presignReq, _ = s3Client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+	}
+	return presignReq.Presign(validity)
+}
+
+// resourceKeyHMACCredentials returns the HMAC credentials of a COS resource key that was
+// created with the HMAC parameter.
+func (r *cosPresignedURLEphemeralResource) resourceKeyHMACCredentials(resourceKeyID string) (string, string, error) {
+	rsContClient, err := r.session.ResourceControllerV2API()
+	if err != nil {
+		return "", "", err
+	}
+	resourceKey, response, err := rsContClient.GetResourceKey(&rc.GetResourceKeyOptions{
+		ID: &resourceKeyID,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("%s with response: %s", err, response)
+	}
+	if resourceKey.Credentials == nil {
+		return "", "", fmt.Errorf("the resource key has no credentials")
+	}
+	if resourceKey.Credentials.Redacted != nil {
+		return "", "", fmt.Errorf("the credentials are redacted with code %s, the user does not have access to view them", *resourceKey.Credentials.Redacted)
+	}
+	hmacKeys, ok := resourceKey.Credentials.GetProperty("cos_hmac_keys").(map[string]interface{})
+	if !ok {
+		return "", "", fmt.Errorf("the resource key was not created with HMAC credentials, set parameters = { HMAC = true } on the resource key")
+	}
+	accessKeyID, _ := hmacKeys["access_key_id"].(string)
+	secretAccessKey, _ := hmacKeys["secret_access_key"].(string)
+	if accessKeyID == "" || secretAccessKey == "" {
+		return "", "", fmt.Errorf("the HMAC credentials of the resource key are incomplete")
+	}
+	return accessKeyID, secretAccessKey, nil
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSPresignedURLEphemeral_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheckCOS(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSPresignedURLEphemeralConfig(acc.BucketName, "GET"),
+			},
+			{
+				Config: testAccIBMCOSPresignedURLEphemeralConfig(acc.BucketName, "PUT"),
+			},
+		},
+	})
+}
+
+func TestAccIBMCOSPresignedURLEphemeral_invalidMethod(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheckCOS(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIBMCOSPresignedURLEphemeralConfig(acc.BucketName, "DELETE"),
+				ExpectError: regexp.MustCompile("Invalid Method"),
+			},
+		},
+	})
+}
+
+func TestAccIBMCOSPresignedURLEphemeral_missingCredentials(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheckCOS(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					ephemeral "ibm_cos_presigned_url" "testacc" {
+						bucket_name     = "%s"
+						bucket_location = "us-south"
+						key             = "testacc.txt"
+					}`, acc.BucketName),
+				ExpectError: regexp.MustCompile("Missing HMAC Credentials"),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSPresignedURLEphemeralConfig(bucketName string, method string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_key" "testacc" {
+			name                 = "tf-testacc-cos-hmac"
+			resource_instance_id = "%[3]s"
+			role                 = "Writer"
+			parameters           = { "HMAC" = true }
+		}
+
+		ephemeral "ibm_cos_presigned_url" "testacc" {
+			bucket_name     = "%[1]s"
+			bucket_location = "us-south"
+			key             = "testacc.txt"
+			method          = "%[2]s"
+			expiration      = 600
+			resource_key_id = ibm_resource_key.testacc.id
+		}`, bucketName, method, acc.CosCRN)
+}
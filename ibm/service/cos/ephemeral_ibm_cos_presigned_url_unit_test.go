@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCOSPresignURL(t *testing.T) {
+	const (
+		endpoint        = "s3.us-south.cloud-object-storage.appdomain.cloud"
+		accessKeyID     = "test-access-key"
+		secretAccessKey = "test-secret-key"
+	)
+
+	for _, method := range []string{"GET", "PUT"} {
+		presignedURL, err := cosPresignURL(endpoint, accessKeyID, secretAccessKey, "my-bucket", "dir/my file.txt", method, 10*time.Minute)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", method, err)
+		}
+		u, err := url.Parse(presignedURL)
+		if err != nil {
+			t.Fatalf("%s: invalid URL %s: %s", method, presignedURL, err)
+		}
+		if u.Scheme != "https" || u.Host != endpoint {
+			t.Errorf("%s: expected https://%s, got %s://%s", method, endpoint, u.Scheme, u.Host)
+		}
+		if u.EscapedPath() != "/my-bucket/dir/my%20file.txt" {
+			t.Errorf("%s: unexpected path %s", method, u.EscapedPath())
+		}
+		query := u.Query()
+		if query.Get("X-Amz-Expires") != "600" {
+			t.Errorf("%s: expected X-Amz-Expires 600, got %s", method, query.Get("X-Amz-Expires"))
+		}
+		if !strings.HasPrefix(query.Get("X-Amz-Credential"), accessKeyID+"/") || !strings.Contains(query.Get("X-Amz-Credential"), "/"+cosPresignRegion+"/s3/") {
+			t.Errorf("%s: unexpected X-Amz-Credential %s", method, query.Get("X-Amz-Credential"))
+		}
+
+		// The method is not in the URL, it is part of the signature
+		otherMethod := "PUT"
+		if method == "PUT" {
+			otherMethod = "GET"
+		}
+		signature := query.Get("X-Amz-Signature")
+		if expected := testCOSPresignSignature(u, method, secretAccessKey); signature != expected {
+			t.Errorf("%s: expected signature %s, got %s", method, expected, signature)
+		}
+		if signature == testCOSPresignSignature(u, otherMethod, secretAccessKey) {
+			t.Errorf("%s: the URL is also signed for %s", method, otherMethod)
+		}
+	}
+}
+
+// testCOSPresignSignature computes the SigV4 query signature of a presigned URL for the method.
+func testCOSPresignSignature(u *url.URL, method, secretAccessKey string) string {
+	query := u.Query()
+	query.Del("X-Amz-Signature")
+	amzDate := query.Get("X-Amz-Date")
+	scope := strings.SplitN(query.Get("X-Amz-Credential"), "/", 2)[1]
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		strings.ReplaceAll(query.Encode(), "+", "%20"),
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range strings.Split(scope, "/") {
+		key = testCOSHMAC(key, part)
+	}
+	return hex.EncodeToString(testCOSHMAC(key, stringToSign))
+}
+
+func testCOSHMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,342 @@
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The COS S3 SDK does not model the bucket notification API, so the operations and
+// payloads are declared here in the same form as the generated SDK operations.
+const (
+	opGetBucketNotificationConfiguration = "GetBucketNotificationConfiguration"
+	opPutBucketNotificationConfiguration = "PutBucketNotificationConfiguration"
+)
+
+type getBucketNotificationConfigurationInput struct {
+	_ struct{} `locationName:"GetBucketNotificationConfigurationRequest" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type putBucketNotificationConfigurationInput struct {
+	_ struct{} `locationName:"PutBucketNotificationConfigurationRequest" type:"structure" payload:"NotificationConfiguration"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	NotificationConfiguration *bucketNotificationConfiguration `locationName:"NotificationConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type putBucketNotificationConfigurationOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+type bucketNotificationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	TopicConfigurations []*bucketNotificationTopicConfiguration `locationName:"TopicConfiguration" type:"list" flattened:"true"`
+}
+
+type bucketNotificationTopicConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Events []*string `locationName:"Event" type:"list" flattened:"true" required:"true"`
+
+	Filter *bucketNotificationFilter `type:"structure"`
+
+	Id *string `type:"string"`
+
+	Topic *string `locationName:"Topic" type:"string" required:"true"`
+}
+
+type bucketNotificationFilter struct {
+	_ struct{} `type:"structure"`
+
+	Key *bucketNotificationKeyFilter `locationName:"S3Key" type:"structure"`
+}
+
+type bucketNotificationKeyFilter struct {
+	_ struct{} `type:"structure"`
+
+	FilterRules []*bucketNotificationFilterRule `locationName:"FilterRule" type:"list" flattened:"true"`
+}
+
+type bucketNotificationFilterRule struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `type:"string"`
+
+	Value *string `type:"string"`
+}
+
+func getBucketNotificationConfiguration(ctx context.Context, s3Client *s3.S3, bucketName string) (*bucketNotificationConfiguration, error) {
+	op := &request.Operation{
+		Name:       opGetBucketNotificationConfiguration,
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?notification",
+	}
+	output := &bucketNotificationConfiguration{}
+	req := s3Client.NewRequest(op, &getBucketNotificationConfigurationInput{Bucket: aws.String(bucketName)}, output)
+	req.SetContext(ctx)
+	return output, req.Send()
+}
+
+func putBucketNotificationConfiguration(ctx context.Context, s3Client *s3.S3, bucketName string, configuration *bucketNotificationConfiguration) error {
+	op := &request.Operation{
+		Name:       opPutBucketNotificationConfiguration,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?notification",
+	}
+	input := &putBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucketName),
+		NotificationConfiguration: configuration,
+	}
+	req := s3Client.NewRequest(op, input, &putBucketNotificationConfigurationOutput{})
+	req.SetContext(ctx)
+	return req.Send()
+}
+
+func ResourceIBMCOSBucketNotificationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketNotificationConfigurationCreate,
+		ReadContext:   resourceIBMCOSBucketNotificationConfigurationRead,
+		UpdateContext: resourceIBMCOSBucketNotificationConfigurationUpdate,
+		DeleteContext: resourceIBMCOSBucketNotificationConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"topic_configuration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Event Notifications topics that receive notifications for the events of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier of the notification configuration.",
+						},
+						"topic": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "CRN of the Event Notifications topic that receives the notifications.",
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"s3:ObjectCreated:*", "s3:ObjectCreated:Put", "s3:ObjectCreated:Post", "s3:ObjectCreated:Copy", "s3:ObjectCreated:CompleteMultipartUpload",
+									"s3:ObjectRemoved:*", "s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated",
+								}, false),
+							},
+							Description: "Bucket events for which notifications are sent.",
+						},
+						"filter_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Object key name prefix of the objects for which notifications are sent.",
+						},
+						"filter_suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Object key name suffix of the objects for which notifications are sent.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func notificationConfigurationSet(topicConfigurationList []interface{}) *bucketNotificationConfiguration {
+	configuration := &bucketNotificationConfiguration{}
+	for _, l := range topicConfigurationList {
+		topicMap, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		topicConfiguration := &bucketNotificationTopicConfiguration{
+			Events: aws.StringSlice(flex.ExpandStringList(topicMap["events"].(*schema.Set).List())),
+			Topic:  aws.String(topicMap["topic"].(string)),
+		}
+		if id, ok := topicMap["id"].(string); ok && id != "" {
+			topicConfiguration.Id = aws.String(id)
+		}
+		var filterRules []*bucketNotificationFilterRule
+		if prefix, ok := topicMap["filter_prefix"].(string); ok && prefix != "" {
+			filterRules = append(filterRules, &bucketNotificationFilterRule{Name: aws.String("prefix"), Value: aws.String(prefix)})
+		}
+		if suffix, ok := topicMap["filter_suffix"].(string); ok && suffix != "" {
+			filterRules = append(filterRules, &bucketNotificationFilterRule{Name: aws.String("suffix"), Value: aws.String(suffix)})
+		}
+		if len(filterRules) > 0 {
+			topicConfiguration.Filter = &bucketNotificationFilter{
+				Key: &bucketNotificationKeyFilter{FilterRules: filterRules},
+			}
+		}
+		configuration.TopicConfigurations = append(configuration.TopicConfigurations, topicConfiguration)
+	}
+	return configuration
+}
+
+func notificationConfigurationGet(in *bucketNotificationConfiguration) []map[string]interface{} {
+	topicConfigurations := make([]map[string]interface{}, 0, len(in.TopicConfigurations))
+	for _, topicConfiguration := range in.TopicConfigurations {
+		if topicConfiguration == nil {
+			continue
+		}
+		topicMap := map[string]interface{}{
+			"id":     aws.StringValue(topicConfiguration.Id),
+			"topic":  aws.StringValue(topicConfiguration.Topic),
+			"events": flex.FlattenStringList(aws.StringValueSlice(topicConfiguration.Events)),
+		}
+		if topicConfiguration.Filter != nil && topicConfiguration.Filter.Key != nil {
+			for _, rule := range topicConfiguration.Filter.Key.FilterRules {
+				switch strings.ToLower(aws.StringValue(rule.Name)) {
+				case "prefix":
+					topicMap["filter_prefix"] = aws.StringValue(rule.Value)
+				case "suffix":
+					topicMap["filter_suffix"] = aws.StringValue(rule.Value)
+				}
+			}
+		}
+		topicConfigurations = append(topicConfigurations, topicMap)
+	}
+	return topicConfigurations
+}
+
+func resourceIBMCOSBucketNotificationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	err = putBucketNotificationConfiguration(ctx, s3Client, bucketName, notificationConfigurationSet(d.Get("topic_configuration").([]interface{})))
+	if err != nil {
+		return diag.Errorf("failed to put notification configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketNotificationConfigurationRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketNotificationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := d.Get("endpoint_type").(string)
+	if d.HasChange("topic_configuration") {
+		bxSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return diag.Errorf("%v", err)
+		}
+		s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return diag.Errorf("%v", err)
+		}
+		err = putBucketNotificationConfiguration(ctx, s3Client, bucketName, notificationConfigurationSet(d.Get("topic_configuration").([]interface{})))
+		if err != nil {
+			return diag.Errorf("failed to update notification configuration on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketNotificationConfigurationRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketNotificationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := parseWebsiteId(d.Id(), "bucketCRN")
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	output, err := getBucketNotificationConfiguration(ctx, s3Client, bucketName)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error getting notification configuration for the bucket %s, %v", bucketName, err)
+	}
+	if len(output.TopicConfigurations) == 0 {
+		// The notification configuration was removed outside of Terraform.
+		d.SetId("")
+		return nil
+	}
+	d.Set("topic_configuration", notificationConfigurationGet(output))
+	return nil
+}
+
+func resourceIBMCOSBucketNotificationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	// An empty notification configuration removes all notifications from the bucket.
+	err = putBucketNotificationConfiguration(ctx, s3Client, bucketName, &bucketNotificationConfiguration{})
+	if err != nil {
+		return diag.Errorf("failed to delete the notification configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Notification_Configuration_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-notification%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+	topicCRN := acc.CosEventNotificationsTopicCRN
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Notification_Configuration_Basic(serviceName, bucketName, bucketRegionType, bucketRegion, bucketClass, topicCRN, "s3:ObjectCreated:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_notification_configuration.notification", "topic_configuration.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_notification_configuration.notification", "topic_configuration.0.topic", topicCRN),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_notification_configuration.notification", "topic_configuration.0.events.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_notification_configuration.notification", "topic_configuration.0.filter_prefix", "logs/"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_notification_configuration.notification", "topic_configuration.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_Notification_Configuration_Basic(serviceName, bucketName, bucketRegionType, bucketRegion, bucketClass, topicCRN, "s3:ObjectRemoved:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_notification_configuration.notification", "topic_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttr("ibm_cos_bucket_notification_configuration.notification", "topic_configuration.0.events.*", "s3:ObjectRemoved:*"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_notification_configuration.notification",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_Notification_Configuration_Basic(cosServiceName string, bucketName string, regiontype string, region string, storageClass string, topicCRN string, event string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+	}
+
+	resource ibm_cos_bucket_notification_configuration "notification" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		topic_configuration {
+			topic         = "%s"
+			events        = ["%s"]
+			filter_prefix = "logs/"
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, topicCRN, event)
+}
@@ -0,0 +1,428 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Files larger than the part size are uploaded with multipart uploads. The part size is
+// fixed so that the ETag of a multipart upload can be computed locally for comparison.
+const cosObjectsSyncPartSize = 16 * 1024 * 1024
+
+// Maximum number of keys in a single DeleteObjects request.
+const cosObjectsSyncDeleteBatchSize = 1000
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the local directory that is mirrored to the bucket.",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Key prefix under which the files of the directory are stored in the bucket.",
+			},
+			"delete_orphans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete objects under the prefix that do not have a matching file in the source directory.",
+			},
+			"objects": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Synchronized objects, keyed by object key with the ETag of the object as value.",
+			},
+		},
+	}
+}
+
+// cosObjectsSyncKey returns the object key of a file relative to the source directory.
+func cosObjectsSyncKey(prefix, relPath string) string {
+	key := filepath.ToSlash(relPath)
+	if prefix != "" {
+		key = path.Join(prefix, key)
+	}
+	return key
+}
+
+// cosObjectsSyncListPrefix returns the prefix used to list the synchronized objects, so that
+// objects of a sibling prefix such as "site2/" are never listed for "site".
+func cosObjectsSyncListPrefix(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+	return strings.TrimSuffix(prefix, "/") + "/"
+}
+
+// cosObjectETag returns the ETag that COS computes for the file when it is uploaded with
+// the sync part size: the MD5 of the content for single part uploads, and the MD5 of the
+// concatenated part MD5s followed by the number of parts for multipart uploads.
+func cosObjectETag(filePath string, size int64) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if size <= cosObjectsSyncPartSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	var partSums []byte
+	parts := 0
+	for {
+		hash := md5.New()
+		n, err := io.CopyN(hash, file, cosObjectsSyncPartSize)
+		if n > 0 {
+			partSums = append(partSums, hash.Sum(nil)...)
+			parts++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	sum := md5.Sum(partSums)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// cosObjectsSyncLocalFiles walks the source directory and returns the local path and the
+// expected ETag of every regular file, keyed by object key.
+func cosObjectsSyncLocalFiles(sourceDir, prefix string) (map[string]string, map[string]string, error) {
+	paths := make(map[string]string)
+	etags := make(map[string]string)
+	err := filepath.WalkDir(sourceDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		etag, err := cosObjectETag(filePath, info.Size())
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error reading file (%s): %s", filePath, err)
+		}
+		key := cosObjectsSyncKey(prefix, relPath)
+		paths[key] = filePath
+		etags[key] = etag
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return paths, etags, nil
+}
+
+// cosObjectsSyncRemoteObjects lists the objects under the prefix with their ETags.
+func cosObjectsSyncRemoteObjects(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	objects := make(map[string]string)
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if listPrefix := cosObjectsSyncListPrefix(prefix); listPrefix != "" {
+		input.Prefix = aws.String(listPrefix)
+	}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func resourceIBMCOSBucketObjectsSyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("prefix") {
+		return diff.SetNewComputed("objects")
+	}
+	_, etags, err := cosObjectsSyncLocalFiles(diff.Get("source_dir").(string), diff.Get("prefix").(string))
+	if err != nil {
+		return err
+	}
+	objects := make(map[string]interface{}, len(etags))
+	for key, etag := range etags {
+		objects[key] = etag
+	}
+	old := diff.Get("objects").(map[string]interface{})
+	if diff.Id() != "" && len(old) == len(objects) {
+		changed := false
+		for key, etag := range objects {
+			if old[key] != etag {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+	return diff.SetNew("objects", objects)
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	prefix := d.Get("prefix").(string)
+
+	if err := cosObjectsSync(ctx, d, meta, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:objects:%s:location:%s", bucketCRN, prefix, bucketLocation))
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("source_dir", "delete_orphans", "objects") {
+		previous, _ := d.GetChange("objects")
+		if err := cosObjectsSync(ctx, d, meta, previous.(map[string]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, meta)
+}
+
+// cosObjectsSync uploads the files of the source directory whose ETag differs from the
+// object in the bucket and deletes the previously synchronized objects whose file was
+// removed. If requested, all other objects without a matching file are deleted as well.
+func cosObjectsSync(ctx context.Context, d *schema.ResourceData, meta interface{}, previous map[string]interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	sourceDir := d.Get("source_dir").(string)
+	prefix := d.Get("prefix").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	paths, etags, err := cosObjectsSyncLocalFiles(sourceDir, prefix)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading source directory (%s): %s", sourceDir, err)
+	}
+	remote, err := cosObjectsSyncRemoteObjects(ctx, s3Client, bucketName, prefix)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing objects in COS bucket (%s): %s", bucketName, err)
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = cosObjectsSyncPartSize
+	})
+	uploaded := 0
+	for key, etag := range etags {
+		if remote[key] == etag {
+			continue
+		}
+		if err := cosObjectsSyncUpload(ctx, uploader, bucketName, key, paths[key]); err != nil {
+			return err
+		}
+		uploaded++
+	}
+	log.Printf("[INFO] Uploaded %d of %d files from (%s) to COS bucket (%s)", uploaded, len(etags), sourceDir, bucketName)
+
+	deleteOrphans := d.Get("delete_orphans").(bool)
+	var orphans []string
+	for key := range remote {
+		if _, ok := etags[key]; ok {
+			continue
+		}
+		if _, ok := previous[key]; ok || deleteOrphans {
+			orphans = append(orphans, key)
+		}
+	}
+	return cosObjectsSyncDelete(ctx, s3Client, bucketName, orphans)
+}
+
+func cosObjectsSyncUpload(ctx context.Context, uploader *s3manager.Uploader, bucketName, key, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", filePath, err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", filePath, err)
+		}
+	}()
+
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+		Body:   file,
+	}
+	if contentType := mime.TypeByExtension(filepath.Ext(filePath)); contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
+
+func cosObjectsSyncDelete(ctx context.Context, s3Client *s3.S3, bucketName string, keys []string) error {
+	for start := 0; start < len(keys); start += cosObjectsSyncDeleteBatchSize {
+		end := min(start+cosObjectsSyncDeleteBatchSize, len(keys))
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting objects from COS bucket (%s): %s", bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("[ERROR] Error deleting object (%s) from COS bucket (%s): %s", aws.StringValue(out.Errors[0].Key), bucketName, aws.StringValue(out.Errors[0].Message))
+		}
+		log.Printf("[INFO] Deleted %d objects from COS bucket (%s)", len(objects), bucketName)
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	prefix := d.Get("prefix").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := cosObjectsSyncRemoteObjects(ctx, s3Client, bucketName, prefix)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing objects in COS bucket (%s): %s", bucketName, err))
+	}
+
+	// Objects that are not managed by this resource are only tracked when orphans are
+	// deleted, so that they show up as a change that removes them.
+	deleteOrphans := d.Get("delete_orphans").(bool)
+	managed := d.Get("objects").(map[string]interface{})
+	objects := make(map[string]string)
+	for key, etag := range remote {
+		if _, ok := managed[key]; ok || deleteOrphans {
+			objects[key] = etag
+		}
+	}
+	d.Set("objects", objects)
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0)
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := cosObjectsSyncDelete(ctx, s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	sourceDir := t.TempDir()
+	writeFile := func(name, content string) {
+		filePath := filepath.Join(sourceDir, name)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html>Acceptance Testing</html>")
+	writeFile("css/site.css", "body {}")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "objects.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "objects.site/css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html>Acceptance Testing updated</html>")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "objects.%", "1"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "objects.site/index.html"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_objects_sync.testacc", "objects.site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsSyncConfig(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			force_delete         = true
+		}
+		resource "ibm_cos_bucket_objects_sync" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source_dir      = "%[3]s"
+			prefix          = "site/"
+			delete_orphans  = true
+		}`, name, instanceCRN, sourceDir)
+}
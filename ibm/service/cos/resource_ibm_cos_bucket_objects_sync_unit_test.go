@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCOSObjectETag(t *testing.T) {
+	content := func(size int) []byte {
+		return bytes.Repeat([]byte("0123456789abcdef"), size/16+1)[:size]
+	}
+
+	// The expected ETags are the MD5 of the content for single part uploads, and the MD5 of the
+	// concatenated MD5s of the 16 MiB parts followed by the number of parts for multipart uploads.
+	cases := []struct {
+		name string
+		size int
+		etag string
+	}{
+		{"empty", 0, "d41d8cd98f00b204e9800998ecf8427e"},
+		{"single part", 1024, "7dd7e68f1907b4665684355797725b8e"},
+		{"exactly one part", cosObjectsSyncPartSize, "6ecc41548d0ae592eca561af2e274024"},
+		{"multipart", cosObjectsSyncPartSize + 1, "9062322c8dbedf73b9ca93754bc21dde-2"},
+		{"exact multiple of the part size", 2 * cosObjectsSyncPartSize, "d2dfa12e55421fd2102246144dfb68cb-2"},
+		{"three parts", 2*cosObjectsSyncPartSize + 5, "030ec9ba2aba7d98a635477cc952be6e-3"},
+	}
+	for _, c := range cases {
+		filePath := filepath.Join(t.TempDir(), "object")
+		if err := os.WriteFile(filePath, content(c.size), 0600); err != nil {
+			t.Fatal(err)
+		}
+		etag, err := cosObjectETag(filePath, int64(c.size))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+		if etag != c.etag {
+			t.Errorf("%s: expected ETag %s, got %s", c.name, c.etag, etag)
+		}
+	}
+}
+
+func TestCOSObjectsSyncKey(t *testing.T) {
+	cases := []struct {
+		prefix, relPath, key string
+	}{
+		{"", "index.html", "index.html"},
+		{"", filepath.Join("css", "site.css"), "css/site.css"},
+		{"site", "index.html", "site/index.html"},
+		{"site/", filepath.Join("css", "site.css"), "site/css/site.css"},
+		{"a/b", filepath.Join("c", "d", "e.txt"), "a/b/c/d/e.txt"},
+	}
+	for _, c := range cases {
+		if key := cosObjectsSyncKey(c.prefix, c.relPath); key != c.key {
+			t.Errorf("prefix %q and path %q: expected key %q, got %q", c.prefix, c.relPath, c.key, key)
+		}
+	}
+
+	for prefix, expected := range map[string]string{"": "", "site": "site/", "site/": "site/"} {
+		if listPrefix := cosObjectsSyncListPrefix(prefix); listPrefix != expected {
+			t.Errorf("prefix %q: expected list prefix %q, got %q", prefix, expected, listPrefix)
+		}
+	}
+}
+
+func TestCOSObjectsSyncLocalFiles(t *testing.T) {
+	dir := t.TempDir()
+	index := writeCOSSyncTestFile(t, dir, "index.html", "<html></html>")
+	css := writeCOSSyncTestFile(t, filepath.Join(dir, "css"), "site.css", "body {}")
+
+	paths, etags, err := cosObjectsSyncLocalFiles(dir, "site")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]string{"site/index.html": index, "site/css/site.css": css}
+	if len(paths) != len(expected) || len(etags) != len(expected) {
+		t.Fatalf("expected %d files, got %v and %v", len(expected), paths, etags)
+	}
+	for key, filePath := range expected {
+		if paths[key] != filePath {
+			t.Errorf("key %s: expected path %s, got %s", key, filePath, paths[key])
+		}
+		data, _ := os.ReadFile(filePath)
+		sum := md5.Sum(data)
+		if etags[key] != hex.EncodeToString(sum[:]) {
+			t.Errorf("key %s: unexpected ETag %s", key, etags[key])
+		}
+	}
+}
+
+func writeCOSSyncTestFile(t *testing.T, dir, name, content string) string {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(dir, name)
+	if err := os.WriteFile(filePath, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filePath
+}
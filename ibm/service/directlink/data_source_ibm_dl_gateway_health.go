@@ -0,0 +1,401 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	dlHealthGenerateRouteReport       = "generate_route_report"
+	dlHealthExpectedAdvertised        = "expected_advertised_prefixes"
+	dlHealthExpectedReceived          = "expected_received_prefixes"
+	dlHealthMinReceivedPrefixCount    = "min_received_prefix_count"
+	dlHealthMaxReceivedPrefixCount    = "max_received_prefix_count"
+	dlHealthFailOnUnhealthy           = "fail_on_unhealthy"
+	dlHealthMacsecActive              = "macsec_active"
+	dlHealthMacsecStatus              = "macsec_status"
+	dlHealthMacsecStatusReasons       = "macsec_status_reasons"
+	dlHealthAdvertisedPrefixes        = "advertised_prefixes"
+	dlHealthReceivedPrefixes          = "received_prefixes"
+	dlHealthAdvertisedPrefixCount     = "advertised_prefix_count"
+	dlHealthReceivedPrefixCount       = "received_prefix_count"
+	dlHealthMissingAdvertised         = "missing_advertised_prefixes"
+	dlHealthMissingReceived           = "missing_received_prefixes"
+	dlHealthRouteReportCreatedAt      = "route_report_created_at"
+	dlHealthHealthy                   = "healthy"
+	dlHealthFailures                  = "failures"
+	dlHealthOperationalStatusExpected = "provisioned"
+	dlHealthBgpStatusExpected         = "established"
+	dlHealthLinkStatusExpected        = "up"
+	dlHealthMacsecStatusExpected      = "secured"
+)
+
+func DataSourceIBMDLGatewayHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDLGatewayHealthRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			dlGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Direct Link gateway identifier",
+			},
+			dlHealthGenerateRouteReport: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Generate a new route report for the prefix counts. When false, the most recent complete route report of the gateway is used",
+			},
+			dlHealthExpectedAdvertised: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Prefixes that must be advertised to the on-prem network",
+			},
+			dlHealthExpectedReceived: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Prefixes that must be received from the on-prem network",
+			},
+			dlHealthMinReceivedPrefixCount: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Minimum number of prefixes received from the on-prem network",
+			},
+			dlHealthMaxReceivedPrefixCount: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of prefixes received from the on-prem network",
+			},
+			dlHealthFailOnUnhealthy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Return an error when the gateway is not healthy",
+			},
+			dlName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique user-defined name for this gateway",
+			},
+			dlOperationalStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway operational status",
+			},
+			dlBgpStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway BGP status",
+			},
+			dlBgpStatusUpdatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time BGP status was updated",
+			},
+			dlLinkStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway link status",
+			},
+			dlHealthMacsecActive: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the MACsec feature is currently active for the gateway",
+			},
+			dlHealthMacsecStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of MACsec on the gateway",
+			},
+			dlHealthMacsecStatusReasons: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Context for the MACsec status",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dlGatewayMacsecSatusReasonCode: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A snake case string indicating the reason for the status",
+						},
+						dlGatewayMacsecSatusReasonMessage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An explanation of the status reason",
+						},
+					},
+				},
+			},
+			dlRouteReportId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the route report the prefixes were taken from",
+			},
+			dlHealthRouteReportCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the route report was created",
+			},
+			dlHealthAdvertisedPrefixes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Prefixes advertised to the on-prem network",
+			},
+			dlHealthReceivedPrefixes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Prefixes received from the on-prem network",
+			},
+			dlHealthAdvertisedPrefixCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of prefixes advertised to the on-prem network",
+			},
+			dlHealthReceivedPrefixCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of prefixes received from the on-prem network",
+			},
+			dlHealthMissingAdvertised: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Expected advertised prefixes that are not advertised",
+			},
+			dlHealthMissingReceived: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Expected received prefixes that are not received",
+			},
+			dlHealthHealthy: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether all health checks passed",
+			},
+			dlHealthFailures: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The health checks that failed",
+			},
+		},
+	}
+}
+
+func dataSourceIBMDLGatewayHealthRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayId := d.Get(dlGatewayId).(string)
+	failures := make([]string, 0)
+
+	getOptions := &directlinkv1.GetGatewayOptions{
+		ID: &gatewayId,
+	}
+	instanceIntf, response, err := directLink.GetGatewayWithContext(context, getOptions)
+	if (err != nil) || (instanceIntf == nil) {
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Error Getting Direct Link Gateway (%s): %s\n%s", gatewayId, err, response))
+	}
+	instance := instanceIntf.(*directlinkv1.GetGatewayResponse)
+
+	if instance.Name != nil {
+		d.Set(dlName, *instance.Name)
+	}
+	operationalStatus := ""
+	if instance.OperationalStatus != nil {
+		operationalStatus = *instance.OperationalStatus
+	}
+	d.Set(dlOperationalStatus, operationalStatus)
+	if operationalStatus != dlHealthOperationalStatusExpected {
+		failures = append(failures, fmt.Sprintf("operational status is %q, expected %q", operationalStatus, dlHealthOperationalStatusExpected))
+	}
+
+	bgpStatus := ""
+	if instance.BgpStatus != nil {
+		bgpStatus = *instance.BgpStatus
+	}
+	d.Set(dlBgpStatus, bgpStatus)
+	if bgpStatus != dlHealthBgpStatusExpected {
+		failures = append(failures, fmt.Sprintf("BGP status is %q, expected %q", bgpStatus, dlHealthBgpStatusExpected))
+	}
+	if instance.BgpStatusUpdatedAt != nil {
+		d.Set(dlBgpStatusUpdatedAt, instance.BgpStatusUpdatedAt.String())
+	}
+
+	// Only dedicated gateways report a link status.
+	if instance.LinkStatus != nil {
+		d.Set(dlLinkStatus, *instance.LinkStatus)
+		if *instance.LinkStatus != dlHealthLinkStatusExpected {
+			failures = append(failures, fmt.Sprintf("link status is %q, expected %q", *instance.LinkStatus, dlHealthLinkStatusExpected))
+		}
+	}
+
+	macsecActive := false
+	macsecStatusReasons := make([]map[string]interface{}, 0)
+	if instance.Macsec != nil {
+		getGatewayMacsecOptions := &directlinkv1.GetGatewayMacsecOptions{ID: &gatewayId}
+		macsec, response, err := directLink.GetGatewayMacsecWithContext(context, getGatewayMacsecOptions)
+		if err != nil {
+			log.Println("[WARN] Error Get DL Gateway Macsec", response, err)
+			return diag.FromErr(flex.FmtErrorf("[ERROR] Error Getting Direct Link Gateway (%s) MACsec: %s\n%s", gatewayId, err, response))
+		}
+		if macsec.Active != nil {
+			macsecActive = *macsec.Active
+		}
+		macsecStatus := ""
+		if macsec.Status != nil {
+			macsecStatus = *macsec.Status
+		}
+		d.Set(dlHealthMacsecStatus, macsecStatus)
+		for _, statusReason := range macsec.StatusReasons {
+			macsecStatusReasons = append(macsecStatusReasons, map[string]interface{}{
+				dlGatewayMacsecSatusReasonCode:    flex.StringValue(statusReason.Code),
+				dlGatewayMacsecSatusReasonMessage: flex.StringValue(statusReason.Message),
+			})
+		}
+		if macsecActive && macsecStatus != dlHealthMacsecStatusExpected {
+			failures = append(failures, fmt.Sprintf("MACsec status is %q, expected %q", macsecStatus, dlHealthMacsecStatusExpected))
+		}
+	}
+	d.Set(dlHealthMacsecActive, macsecActive)
+	d.Set(dlHealthMacsecStatusReasons, macsecStatusReasons)
+
+	report, err := dlGatewayHealthRouteReport(context, directLink, gatewayId, d.Get(dlHealthGenerateRouteReport).(bool), d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	advertised := make([]string, 0)
+	received := make([]string, 0)
+	if report != nil {
+		d.Set(dlRouteReportId, *report.ID)
+		if report.CreatedAt != nil {
+			d.Set(dlHealthRouteReportCreatedAt, report.CreatedAt.String())
+		}
+		for _, route := range report.AdvertisedRoutes {
+			if route.Prefix != nil {
+				advertised = append(advertised, *route.Prefix)
+			}
+		}
+		for _, route := range report.OnPremRoutes {
+			if route.Prefix != nil {
+				received = append(received, *route.Prefix)
+			}
+		}
+	} else {
+		failures = append(failures, "no complete route report is available")
+	}
+	d.Set(dlHealthAdvertisedPrefixes, advertised)
+	d.Set(dlHealthReceivedPrefixes, received)
+	d.Set(dlHealthAdvertisedPrefixCount, len(advertised))
+	d.Set(dlHealthReceivedPrefixCount, len(received))
+
+	missingAdvertised := dlGatewayHealthMissingPrefixes(d.Get(dlHealthExpectedAdvertised).(*schema.Set), advertised)
+	if len(missingAdvertised) > 0 {
+		failures = append(failures, fmt.Sprintf("expected advertised prefixes are missing: %s", strings.Join(missingAdvertised, ", ")))
+	}
+	d.Set(dlHealthMissingAdvertised, missingAdvertised)
+
+	missingReceived := dlGatewayHealthMissingPrefixes(d.Get(dlHealthExpectedReceived).(*schema.Set), received)
+	if len(missingReceived) > 0 {
+		failures = append(failures, fmt.Sprintf("expected received prefixes are missing: %s", strings.Join(missingReceived, ", ")))
+	}
+	d.Set(dlHealthMissingReceived, missingReceived)
+
+	if min, ok := d.GetOk(dlHealthMinReceivedPrefixCount); ok && len(received) < min.(int) {
+		failures = append(failures, fmt.Sprintf("%d prefixes received, expected at least %d", len(received), min.(int)))
+	}
+	if max, ok := d.GetOk(dlHealthMaxReceivedPrefixCount); ok && len(received) > max.(int) {
+		failures = append(failures, fmt.Sprintf("%d prefixes received, expected at most %d", len(received), max.(int)))
+	}
+
+	d.Set(dlHealthHealthy, len(failures) == 0)
+	d.Set(dlHealthFailures, failures)
+	d.SetId(gatewayId)
+
+	if len(failures) > 0 && d.Get(dlHealthFailOnUnhealthy).(bool) {
+		return diag.FromErr(flex.FmtErrorf("[ERROR] Direct Link gateway (%s) is not healthy: %s", gatewayId, strings.Join(failures, "; ")))
+	}
+	return nil
+}
+
+// dlGatewayHealthRouteReport returns a route report of the gateway. A generated report is deleted once
+// it is complete, so that health checks do not accumulate reports on the gateway.
+func dlGatewayHealthRouteReport(context context.Context, directLink *directlinkv1.DirectLinkV1, gatewayId string, generate bool, timeout time.Duration) (*directlinkv1.RouteReport, error) {
+	if !generate {
+		listOptions := &directlinkv1.ListGatewayRouteReportsOptions{GatewayID: &gatewayId}
+		reports, response, err := directLink.ListGatewayRouteReportsWithContext(context, listOptions)
+		if err != nil {
+			return nil, flex.FmtErrorf("[ERROR] Error fetching DL Route Reports for gateway(%s) err: %s\n%s", gatewayId, err, response)
+		}
+		complete := make([]directlinkv1.RouteReport, 0)
+		for _, report := range reports.RouteReports {
+			if report.Status != nil && *report.Status == dlRouteReportComplete && report.CreatedAt != nil {
+				complete = append(complete, report)
+			}
+		}
+		if len(complete) == 0 {
+			return nil, nil
+		}
+		sort.Slice(complete, func(i, j int) bool {
+			return time.Time(*complete[i].CreatedAt).After(time.Time(*complete[j].CreatedAt))
+		})
+		return &complete[0], nil
+	}
+
+	createOptions := &directlinkv1.CreateGatewayRouteReportOptions{GatewayID: &gatewayId}
+	routeReport, response, err := directLink.CreateGatewayRouteReportWithContext(context, createOptions)
+	if err != nil || routeReport == nil || routeReport.ID == nil {
+		return nil, flex.FmtErrorf("[ERROR] Create Route Report for DirectLink gateway(%s) err: %s\n%s", gatewayId, err, response)
+	}
+	routeReportId := *routeReport.ID
+	defer func() {
+		deleteOptions := directLink.NewDeleteGatewayRouteReportOptions(gatewayId, routeReportId)
+		if response, err := directLink.DeleteGatewayRouteReport(deleteOptions); err != nil {
+			log.Printf("[WARN] Error deleting Direct Link Route Report %s: %s\n%s", routeReportId, err, response)
+		}
+	}()
+
+	report, err := isWaitForDirectLinkGatewayRouteReportCompleted(directLink, fmt.Sprintf("%s/%s", gatewayId, routeReportId), timeout)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] Error waiting for DL Route Report (%s) of gateway(%s): %s", routeReportId, gatewayId, err)
+	}
+	return report.(*directlinkv1.RouteReport), nil
+}
+
+// dlGatewayHealthMissingPrefixes returns the expected prefixes that are not in prefixes, sorted.
+func dlGatewayHealthMissingPrefixes(expected *schema.Set, prefixes []string) []string {
+	found := make(map[string]bool, len(prefixes))
+	for _, prefix := range prefixes {
+		found[prefix] = true
+	}
+	missing := make([]string, 0)
+	for _, prefix := range expected.List() {
+		if !found[prefix.(string)] {
+			missing = append(missing, prefix.(string))
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
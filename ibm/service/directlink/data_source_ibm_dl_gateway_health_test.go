@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDLGatewayHealthDataSource_basic(t *testing.T) {
+	node := "data.ibm_dl_gateway_health.dl_gateway_health"
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayHealthDataSourceConfig(gatewayname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(node, "operational_status"),
+					resource.TestCheckResourceAttrSet(node, "route_report_id"),
+					resource.TestCheckResourceAttrSet(node, "healthy"),
+					resource.TestCheckResourceAttr(node, "missing_received_prefixes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLGatewayHealthDataSourceConfig(gatewayname string) string {
+	return fmt.Sprintf(`
+	data "ibm_dl_ports" "ds_dlports" {
+	}
+
+	resource ibm_dl_gateway test_dl_gateway {
+		bgp_asn =  64999
+		global = true
+		metered = false
+		name = "%s"
+		speed_mbps = 1000
+		type =  "connect"
+		port = data.ibm_dl_ports.ds_dlports.ports[0].port_id
+	}
+
+	data "ibm_dl_gateway_health" "dl_gateway_health" {
+		gateway = ibm_dl_gateway.test_dl_gateway.id
+		expected_received_prefixes = ["192.0.2.0/24"]
+	}
+	`, gatewayname)
+}
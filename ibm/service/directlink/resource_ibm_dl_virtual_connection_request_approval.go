@@ -0,0 +1,344 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	dlVCRequestApprovalAction         = "action"
+	dlVCRequestApprovalNamePattern    = "name_pattern"
+	dlVCRequestApprovalWaitForRequest = "wait_for_request"
+	dlVCRequestApprovalPending        = "pending_requests"
+	dlVCRequestApprovalProcessed      = "processed_connections"
+	dlVCRequestApprovalWaiting        = "waiting"
+	dlVCRequestApprovalFound          = "found"
+)
+
+var dlVCRequestApprovalFilters = []string{dlVCNetworkId, dlVCNetworkAccount, dlVCRequestApprovalNamePattern}
+
+func ResourceIBMDLVirtualConnectionRequestApproval() *schema.Resource {
+	connectionSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dlVirtualConnectionId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this virtual connection",
+			},
+			dlVCName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user-defined name for this virtual connection",
+			},
+			dlVCType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of virtual connection",
+			},
+			dlVCNetworkId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique identifier of the target network",
+			},
+			dlVCNetworkAccount: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "For virtual connections across two different IBM Cloud Accounts network_account indicates the account that owns the target network",
+			},
+			dlVCStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the virtual connection",
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceIBMDLVirtualConnectionRequestApprovalCreate,
+		Read:   resourceIBMDLVirtualConnectionRequestApprovalRead,
+		Update: resourceIBMDLVirtualConnectionRequestApprovalUpdate,
+		Delete: resourceIBMDLVirtualConnectionRequestApprovalDelete,
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			// The requesting account chooses the connection name, so the name alone cannot identify
+			// the requests to approve
+			if diff.Get(dlVCRequestApprovalAction).(string) == "approve" &&
+				diff.NewValueKnown(dlVCNetworkId) && diff.Get(dlVCNetworkId).(string) == "" &&
+				diff.NewValueKnown(dlVCNetworkAccount) && diff.Get(dlVCNetworkAccount).(string) == "" {
+				return fmt.Errorf("%s or %s must be set to approve connection requests", dlVCNetworkId, dlVCNetworkAccount)
+			}
+			// Pending requests found during refresh are processed by the next apply
+			if diff.Id() != "" && len(diff.Get(dlVCRequestApprovalPending).([]interface{})) > 0 {
+				return diff.SetNewComputed(dlVCRequestApprovalPending)
+			}
+			return nil
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			dlGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Direct Link gateway identifier",
+			},
+			dlVCRequestApprovalAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_virtual_connection_request_approval", dlVCRequestApprovalAction),
+				Description:  "The action to perform on the matching cross account virtual connection requests",
+			},
+			dlVCNetworkId: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: dlVCRequestApprovalFilters,
+				Description:  "Only process the requests to connect the network with this ID. For type=vpc virtual connections this is the CRN of the target VPC",
+			},
+			dlVCNetworkAccount: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: dlVCRequestApprovalFilters,
+				Description:  "Only process the requests to connect a network of this account",
+			},
+			dlVCRequestApprovalNamePattern: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: dlVCRequestApprovalFilters,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only process the requests whose virtual connection name matches this regular expression",
+			},
+			dlVCType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_virtual_connection_request_approval", dlVCType),
+				Description:  "Only process the requests to connect a network of this type",
+			},
+			dlVCRequestApprovalWaitForRequest: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until at least one matching request is pending when the resource is created",
+			},
+			dlVCRequestApprovalPending: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching virtual connection requests that are pending and are processed by the next apply",
+				Elem:        connectionSchema,
+			},
+			dlVCRequestApprovalProcessed: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The virtual connection requests processed by this resource",
+				Elem:        connectionSchema,
+			},
+		},
+	}
+}
+
+func ResourceIBMDLVirtualConnectionRequestApprovalValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlVCRequestApprovalAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "approve, reject"},
+		validate.ValidateSchema{
+			Identifier:                 dlVCType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "classic, vpc"})
+
+	ibmDLVirtualConnectionRequestApprovalResourceValidator := validate.ResourceValidator{ResourceName: "ibm_dl_virtual_connection_request_approval", Schema: validateSchema}
+	return &ibmDLVirtualConnectionRequestApprovalResourceValidator
+}
+
+func resourceIBMDLVirtualConnectionRequestApprovalCreate(d *schema.ResourceData, meta interface{}) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+	gatewayId := d.Get(dlGatewayId).(string)
+
+	if d.Get(dlVCRequestApprovalWaitForRequest).(bool) {
+		if err := isWaitForDirectLinkVirtualConnectionRequest(directLink, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return flex.FmtErrorf("Error waiting for a virtual connection request to Direct Link gateway (%s): %s", gatewayId, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, d.Get(dlVCRequestApprovalAction).(string)))
+	d.Set(dlVCRequestApprovalProcessed, []map[string]interface{}{})
+	if err := processDirectLinkVirtualConnectionRequests(directLink, d); err != nil {
+		return err
+	}
+	return resourceIBMDLVirtualConnectionRequestApprovalRead(d, meta)
+}
+
+func resourceIBMDLVirtualConnectionRequestApprovalRead(d *schema.ResourceData, meta interface{}) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	pending, response, err := listDirectLinkVirtualConnectionRequests(directLink, d)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	pendingRequests := make([]map[string]interface{}, 0, len(pending))
+	for _, vc := range pending {
+		pendingRequests = append(pendingRequests, flattenDirectLinkVirtualConnectionRequest(vc))
+	}
+	d.Set(dlVCRequestApprovalPending, pendingRequests)
+	return nil
+}
+
+func resourceIBMDLVirtualConnectionRequestApprovalUpdate(d *schema.ResourceData, meta interface{}) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+	if err := processDirectLinkVirtualConnectionRequests(directLink, d); err != nil {
+		return err
+	}
+	return resourceIBMDLVirtualConnectionRequestApprovalRead(d, meta)
+}
+
+func resourceIBMDLVirtualConnectionRequestApprovalDelete(d *schema.ResourceData, meta interface{}) error {
+	// Approved and rejected requests cannot be reverted
+	d.SetId("")
+	return nil
+}
+
+// processDirectLinkVirtualConnectionRequests attaches or rejects the pending virtual connection
+// requests that match the filters, and adds them to the processed connections.
+func processDirectLinkVirtualConnectionRequests(directLink *directlinkv1.DirectLinkV1, d *schema.ResourceData) error {
+	gatewayId := d.Get(dlGatewayId).(string)
+	status := directlinkv1.GatewayVirtualConnectionPatchTemplate_Status_Rejected
+	if d.Get(dlVCRequestApprovalAction).(string) == "approve" {
+		status = directlinkv1.GatewayVirtualConnectionPatchTemplate_Status_Attached
+	}
+
+	pending, _, err := listDirectLinkVirtualConnectionRequests(directLink, d)
+	if err != nil {
+		return err
+	}
+	processed := d.Get(dlVCRequestApprovalProcessed).([]interface{})
+	for _, vc := range pending {
+		patch := map[string]interface{}{dlVCStatus: status}
+		updateGatewayVCOptions := directLink.NewUpdateGatewayVirtualConnectionOptions(gatewayId, *vc.ID, patch)
+		_, response, err := directLink.UpdateGatewayVirtualConnection(updateGatewayVCOptions)
+		if err != nil {
+			d.Set(dlVCRequestApprovalProcessed, processed)
+			return flex.FmtErrorf("Error updating the status of Direct Link Gateway (%s) Virtual Connection (%s) to %s: %s\n%s", gatewayId, *vc.ID, status, err, response)
+		}
+		log.Printf("[INFO] Updated the status of Direct Link Gateway (%s) Virtual Connection (%s) named %s to %s", gatewayId, *vc.ID, *vc.Name, status)
+
+		processedConnection := flattenDirectLinkVirtualConnectionRequest(vc)
+		processedConnection[dlVCStatus] = status
+		processed = append(processed, processedConnection)
+	}
+	d.Set(dlVCRequestApprovalProcessed, processed)
+	return nil
+}
+
+// listDirectLinkVirtualConnectionRequests returns the virtual connections of the gateway that are
+// pending approval and match the filters.
+func listDirectLinkVirtualConnectionRequests(directLink *directlinkv1.DirectLinkV1, d *schema.ResourceData) ([]directlinkv1.GatewayVirtualConnection, *core.DetailedResponse, error) {
+	gatewayId := d.Get(dlGatewayId).(string)
+	networkId := d.Get(dlVCNetworkId).(string)
+	networkAccount := d.Get(dlVCNetworkAccount).(string)
+	vcType := d.Get(dlVCType).(string)
+	var namePattern *regexp.Regexp
+	if pattern := d.Get(dlVCRequestApprovalNamePattern).(string); pattern != "" {
+		namePattern = regexp.MustCompile(pattern)
+	}
+
+	listVcOptions := &directlinkv1.ListGatewayVirtualConnectionsOptions{}
+	listVcOptions.SetGatewayID(gatewayId)
+	listGatewayVirtualConnections, response, err := directLink.ListGatewayVirtualConnections(listVcOptions)
+	if err != nil {
+		return nil, response, flex.FmtErrorf("Error listing Direct Link Gateway (%s) Virtual Connections: %s\n%s", gatewayId, err, response)
+	}
+
+	pending := []directlinkv1.GatewayVirtualConnection{}
+	for _, vc := range listGatewayVirtualConnections.VirtualConnections {
+		if vc.Status == nil || *vc.Status != directlinkv1.GatewayVirtualConnection_Status_ApprovalPending {
+			continue
+		}
+		if networkId != "" && flex.StringValue(vc.NetworkID) != networkId {
+			continue
+		}
+		if networkAccount != "" && flex.StringValue(vc.NetworkAccount) != networkAccount {
+			continue
+		}
+		if vcType != "" && flex.StringValue(vc.Type) != vcType {
+			continue
+		}
+		if namePattern != nil && !namePattern.MatchString(flex.StringValue(vc.Name)) {
+			continue
+		}
+		pending = append(pending, vc)
+	}
+	return pending, nil, nil
+}
+
+func isWaitForDirectLinkVirtualConnectionRequest(directLink *directlinkv1.DirectLinkV1, d *schema.ResourceData, timeout time.Duration) error {
+	log.Printf("Waiting for a virtual connection request to Direct Link gateway (%s).", d.Get(dlGatewayId).(string))
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dlVCRequestApprovalWaiting},
+		Target:  []string{dlVCRequestApprovalFound},
+		Refresh: func() (interface{}, string, error) {
+			pending, _, err := listDirectLinkVirtualConnectionRequests(directLink, d)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(pending) > 0 {
+				return pending, dlVCRequestApprovalFound, nil
+			}
+			return pending, dlVCRequestApprovalWaiting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func flattenDirectLinkVirtualConnectionRequest(vc directlinkv1.GatewayVirtualConnection) map[string]interface{} {
+	return map[string]interface{}{
+		dlVirtualConnectionId: flex.StringValue(vc.ID),
+		dlVCName:              flex.StringValue(vc.Name),
+		dlVCType:              flex.StringValue(vc.Type),
+		dlVCNetworkId:         flex.StringValue(vc.NetworkID),
+		dlVCNetworkAccount:    flex.StringValue(vc.NetworkAccount),
+		dlVCStatus:            flex.StringValue(vc.Status),
+	}
+}
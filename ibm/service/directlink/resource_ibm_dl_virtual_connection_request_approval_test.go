@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDLVirtualConnectionRequestApproval_basic(t *testing.T) {
+	vcName := fmt.Sprintf("vc-name-%d", acctest.RandIntRange(10, 100))
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+	custname := fmt.Sprintf("customer-name-%d", acctest.RandIntRange(10, 100))
+	carriername := fmt.Sprintf("carrier-name-%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("tf-vpcname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLVirtualConnectionRequestApprovalConfig(vcName, gatewayname, custname, carriername, vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dl_virtual_connection_request_approval.test_dl_vc_approval", "processed_connections.#", "1"),
+					resource.TestCheckResourceAttr("ibm_dl_virtual_connection_request_approval.test_dl_vc_approval", "processed_connections.0.name", vcName),
+					resource.TestCheckResourceAttr("ibm_dl_virtual_connection_request_approval.test_dl_vc_approval", "processed_connections.0.status", "attached"),
+					resource.TestCheckResourceAttr("ibm_dl_virtual_connection_request_approval.test_dl_vc_approval", "pending_requests.#", "0"),
+				),
+			},
+		},
+	},
+	)
+}
+
+func testAccCheckIBMDLVirtualConnectionRequestApprovalConfig(vcName, gatewayname, custname, carriername, vpcname string) string {
+	return fmt.Sprintf(`
+	data "ibm_dl_routers" "test1" {
+		offering_type = "dedicated"
+		location_name = "dal10"
+	}
+	resource "ibm_is_vpc" "test_dl_vc_vpc" {
+		provider = ibm.account2
+		name = "%s"
+	}
+	resource "ibm_dl_gateway" "test_dl_gateway" {
+		bgp_asn =  64999
+		global = true
+		metered = false
+		name = "%s"
+		speed_mbps = 1000
+		type = "dedicated"
+		cross_connect_router = data.ibm_dl_routers.test1.cross_connect_routers[0].router_name
+		location_name = data.ibm_dl_routers.test1.location_name
+		customer_name = "%s"
+		carrier_name = "%s"
+	}
+	resource "ibm_dl_virtual_connection" "test_dl_gateway_vc" {
+		gateway = ibm_dl_gateway.test_dl_gateway.id
+		name = "%s"
+		type = "vpc"
+		network_id = ibm_is_vpc.test_dl_vc_vpc.resource_crn
+	}
+	resource "ibm_dl_virtual_connection_request_approval" "test_dl_vc_approval" {
+		provider = ibm.account2
+		depends_on = [ibm_dl_virtual_connection.test_dl_gateway_vc]
+		gateway = ibm_dl_gateway.test_dl_gateway.id
+		action = "approve"
+		network_id = ibm_is_vpc.test_dl_vc_vpc.resource_crn
+		wait_for_request = true
+	}
+	`, vpcname, gatewayname, custname, carriername, vcName)
+}
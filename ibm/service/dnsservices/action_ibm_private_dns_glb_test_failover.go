@@ -0,0 +1,417 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var (
+	_ action.Action                   = &pdnsGLBTestFailoverAction{}
+	_ action.ActionWithConfigure      = &pdnsGLBTestFailoverAction{}
+	_ action.ActionWithValidateConfig = &pdnsGLBTestFailoverAction{}
+)
+
+const (
+	pdnsGLBFailoverPending   = "failing_over"
+	pdnsGLBFailoverCompleted = "failed_over"
+	pdnsGLBFailoverPageLimit = 200
+	// Time allowed to restore the pool once the test is complete, even when the invocation is cancelled.
+	pdnsGLBFailoverRestoreTimeout = 5 * time.Minute
+)
+
+func NewPrivateDNSGLBTestFailoverAction() action.Action {
+	return &pdnsGLBTestFailoverAction{}
+}
+
+type pdnsGLBTestFailoverAction struct {
+	client *dns.DnsSvcsV1
+}
+
+type pdnsGLBTestFailoverModel struct {
+	InstanceID     types.String `tfsdk:"instance_id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	GlbID          types.String `tfsdk:"glb_id"`
+	PoolID         types.String `tfsdk:"pool_id"`
+	OriginName     types.String `tfsdk:"origin_name"`
+	ExpectedPoolID types.String `tfsdk:"expected_pool_id"`
+	Timeout        types.String `tfsdk:"timeout"`
+	VerifyRecovery types.Bool   `tfsdk:"verify_recovery"`
+}
+
+func (a *pdnsGLBTestFailoverAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_dns_glb_test_failover"
+}
+
+func (a *pdnsGLBTestFailoverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tests the failover of a private DNS global load balancer. The action disables the origins of a pool, or a single origin of the pool, waits until the health checks report the pool as critical and the load balancer serves from the expected pool, and restores the pool afterwards, also when the test fails. The serving pool is the first enabled pool of the default pools that the health checks do not report as critical, or the fallback pool. Global load balancers with availability zone pools are not supported, and the pools must have a health check. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the private DNS service instance.",
+			},
+			"zone_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the DNS zone of the global load balancer.",
+			},
+			"glb_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the global load balancer.",
+			},
+			"pool_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the pool to disable during the test.",
+			},
+			"origin_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the origin to disable in the pool. If not specified, all the origins of the pool are disabled.",
+			},
+			"expected_pool_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the pool that the global load balancer must fail over to.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the failover, and for the recovery when verified, for example `10m`. If not specified, defaults to `10m`.",
+			},
+			"verify_recovery": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait until the global load balancer serves from the original pool again once the pool is restored. If not specified, defaults to `true`.",
+			},
+		},
+	}
+}
+
+func (a *pdnsGLBTestFailoverAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config pdnsGLBTestFailoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Timeout.IsNull() && !config.Timeout.IsUnknown() {
+		if _, err := time.ParseDuration(config.Timeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout Format",
+				fmt.Sprintf("Failed to parse timeout '%s': %s. Expected format like '10m' or '1h'.", config.Timeout.ValueString(), err.Error()),
+			)
+		}
+	}
+	if !config.PoolID.IsUnknown() && !config.ExpectedPoolID.IsUnknown() &&
+		pdnsGLBFailoverID(config.PoolID.ValueString()) == pdnsGLBFailoverID(config.ExpectedPoolID.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_pool_id"),
+			"Invalid Expected Pool",
+			"The expected pool must be another pool than the pool that is disabled.",
+		)
+	}
+}
+
+func (a *pdnsGLBTestFailoverAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.PrivateDNSClientSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Private DNS Client",
+			"An unexpected error occurred when creating the private DNS client.\n\n"+
+				"Private DNS Client Error: "+err.Error(),
+		)
+		return
+	}
+	a.client = client
+}
+
+func (a *pdnsGLBTestFailoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config pdnsGLBTestFailoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := config.InstanceID.ValueString()
+	zoneID := pdnsGLBFailoverID(config.ZoneID.ValueString())
+	glbID := pdnsGLBFailoverID(config.GlbID.ValueString())
+	poolID := pdnsGLBFailoverID(config.PoolID.ValueString())
+	expectedPoolID := pdnsGLBFailoverID(config.ExpectedPoolID.ValueString())
+	originName := config.OriginName.ValueString()
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		if d, err := time.ParseDuration(config.Timeout.ValueString()); err == nil {
+			timeout = d
+		}
+	}
+
+	glb, pools, err := a.getGLB(ctx, instanceID, zoneID, glbID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Global Load Balancer", err.Error())
+		return
+	}
+	if len(glb.AzPools) > 0 {
+		resp.Diagnostics.AddError(
+			"Unsupported Global Load Balancer",
+			fmt.Sprintf("The failover of global load balancer '%s' cannot be tested: availability zone pools are configured.", glbID),
+		)
+		return
+	}
+	for _, id := range []string{poolID, expectedPoolID} {
+		if !slices.Contains(glb.DefaultPools, id) && (glb.FallbackPool == nil || *glb.FallbackPool != id) {
+			resp.Diagnostics.AddError(
+				"Pool Not Used by Global Load Balancer",
+				fmt.Sprintf("Pool '%s' is neither a default pool nor the fallback pool of global load balancer '%s'.", id, glbID),
+			)
+			return
+		}
+		if pool, ok := pools[id]; ok && (pool.Monitor == nil || *pool.Monitor == "") {
+			resp.Diagnostics.AddError(
+				"Pool Without Health Check",
+				fmt.Sprintf("Pool '%s' has no health check, so its health is not reported and the failover cannot be observed.", id),
+			)
+			return
+		}
+	}
+	original, ok := pools[poolID]
+	if !ok {
+		resp.Diagnostics.AddError("Pool Not Found", fmt.Sprintf("Pool '%s' was not found in private DNS instance '%s'.", poolID, instanceID))
+		return
+	}
+	originalServingPool := pdnsGLBServingPool(glb, pools)
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Global load balancer '%s' serves from pool '%s'", glbID, originalServingPool),
+	})
+	if originalServingPool == expectedPoolID {
+		resp.Diagnostics.AddError(
+			"Global Load Balancer Already Failed Over",
+			fmt.Sprintf("Global load balancer '%s' already serves from the expected pool '%s', the failover cannot be tested.", glbID, expectedPoolID),
+		)
+		return
+	}
+
+	// The origins are disabled rather than the pool, so that the pool keeps its place in the
+	// default pools and the failover only happens once the health checks report the pool critical.
+	disabled := original
+	disabled.Origins = append([]dns.Origin{}, original.Origins...)
+	found := false
+	for i, origin := range disabled.Origins {
+		if originName == "" || (origin.Name != nil && *origin.Name == originName) {
+			origin.Enabled = core.BoolPtr(false)
+			disabled.Origins[i] = origin
+			found = true
+		}
+	}
+	if !found {
+		resp.Diagnostics.AddError("Origin Not Found", fmt.Sprintf("Pool '%s' has no origin named '%s'.", poolID, originName))
+		return
+	}
+
+	if err := a.testFailover(ctx, instanceID, zoneID, glbID, poolID, originName, expectedPoolID, original, disabled, timeout, resp); err != nil {
+		resp.Diagnostics.AddError(
+			"Global Load Balancer Failover Failed",
+			fmt.Sprintf("Global load balancer '%s' did not fail over to pool '%s': %s", glbID, expectedPoolID, err.Error()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.VerifyRecovery.IsNull() || config.VerifyRecovery.ValueBool() {
+		if err := a.waitForServingPool(ctx, instanceID, zoneID, glbID, originalServingPool, "", timeout, resp.SendProgress); err != nil {
+			resp.Diagnostics.AddError(
+				"Global Load Balancer Recovery Failed",
+				fmt.Sprintf("Global load balancer '%s' did not return to pool '%s' once the pool was restored: %s", glbID, originalServingPool, err.Error()),
+			)
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Global load balancer '%s' serves from pool '%s' again", glbID, originalServingPool),
+		})
+	}
+}
+
+// testFailover disables the origins of the pool and waits for the failover to the expected pool. The
+// pool is restored when the function returns, whatever the outcome of the test, also when the
+// invocation is cancelled or panics.
+func (a *pdnsGLBTestFailoverAction) testFailover(ctx context.Context, instanceID, zoneID, glbID, poolID, originName, expectedPoolID string, original, disabled dns.Pool, timeout time.Duration, resp *action.InvokeResponse) error {
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Disabling %s...", pdnsGLBFailoverTarget(poolID, originName)),
+	})
+	if err := a.updatePool(ctx, instanceID, poolID, disabled); err != nil {
+		return fmt.Errorf("failed to disable %s: %s", pdnsGLBFailoverTarget(poolID, originName), err.Error())
+	}
+	defer func() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Restoring %s...", pdnsGLBFailoverTarget(poolID, originName)),
+		})
+		restoreCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), pdnsGLBFailoverRestoreTimeout)
+		defer cancel()
+		if err := a.updatePool(restoreCtx, instanceID, poolID, original); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Restore Pool",
+				fmt.Sprintf("%s. Pool '%s' must be restored manually.", err.Error(), poolID),
+			)
+		}
+	}()
+
+	if err := a.waitForServingPool(ctx, instanceID, zoneID, glbID, expectedPoolID, poolID, timeout, resp.SendProgress); err != nil {
+		return err
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Global load balancer '%s' failed over to pool '%s'", glbID, expectedPoolID),
+	})
+	return nil
+}
+
+// getGLB returns the global load balancer and the pools of the private DNS instance by ID.
+func (a *pdnsGLBTestFailoverAction) getGLB(ctx context.Context, instanceID, zoneID, glbID string) (*dns.LoadBalancer, map[string]dns.Pool, error) {
+	glb, resp, err := a.client.GetLoadBalancerWithContext(ctx, a.client.NewGetLoadBalancerOptions(instanceID, zoneID, glbID))
+	if err != nil || glb == nil {
+		return nil, nil, fmt.Errorf("failed to get global load balancer '%s': %v\n%s", glbID, err, resp)
+	}
+
+	pools := map[string]dns.Pool{}
+	opt := a.client.NewListPoolsOptions(instanceID)
+	opt.SetLimit(pdnsGLBFailoverPageLimit)
+	offset := int64(0)
+	for {
+		opt.SetOffset(offset)
+		result, resp, err := a.client.ListPoolsWithContext(ctx, opt)
+		if err != nil || result == nil {
+			return nil, nil, fmt.Errorf("failed to list pools: %v\n%s", err, resp)
+		}
+		for _, pool := range result.Pools {
+			if pool.ID != nil {
+				pools[*pool.ID] = pool
+			}
+		}
+		offset += int64(len(result.Pools))
+		if len(result.Pools) == 0 || result.TotalCount == nil || offset >= *result.TotalCount {
+			break
+		}
+	}
+	return glb, pools, nil
+}
+
+// updatePool replaces the settings of a pool with the settings of pool.
+func (a *pdnsGLBTestFailoverAction) updatePool(ctx context.Context, instanceID, poolID string, pool dns.Pool) error {
+	opt := a.client.NewUpdatePoolOptions(instanceID, poolID)
+	opt.Name = pool.Name
+	opt.Description = pool.Description
+	opt.Enabled = pool.Enabled
+	opt.HealthyOriginsThreshold = pool.HealthyOriginsThreshold
+	opt.Monitor = pool.Monitor
+	opt.NotificationChannel = pool.NotificationChannel
+	opt.HealthcheckRegion = pool.HealthcheckRegion
+	opt.HealthcheckSubnets = pool.HealthcheckSubnets
+	origins := make([]dns.OriginInput, 0, len(pool.Origins))
+	for _, origin := range pool.Origins {
+		origins = append(origins, dns.OriginInput{
+			Name:        origin.Name,
+			Description: origin.Description,
+			Address:     origin.Address,
+			Enabled:     origin.Enabled,
+		})
+	}
+	opt.SetOrigins(origins)
+	if _, resp, err := a.client.UpdatePoolWithContext(ctx, opt); err != nil {
+		return fmt.Errorf("failed to update pool '%s': %s\n%s", poolID, err.Error(), resp)
+	}
+	return nil
+}
+
+// waitForServingPool waits until the global load balancer serves from the pool, and until the health
+// checks report the unhealthy pool as critical when it is set. Each change of the serving pool is
+// reported as progress.
+func (a *pdnsGLBTestFailoverAction) waitForServingPool(ctx context.Context, instanceID, zoneID, glbID, poolID, unhealthyPoolID string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) error {
+	log.Printf("Waiting for global load balancer (%s) to serve from pool %s.", glbID, poolID)
+
+	lastPool := ""
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{pdnsGLBFailoverPending},
+		Target:  []string{pdnsGLBFailoverCompleted},
+		Refresh: func() (interface{}, string, error) {
+			glb, pools, err := a.getGLB(ctx, instanceID, zoneID, glbID)
+			if err != nil {
+				return nil, "", err
+			}
+			serving := pdnsGLBServingPool(glb, pools)
+			if serving != lastPool {
+				sendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Global load balancer '%s' serves from pool '%s' (health %s)", glbID, serving, flex.StringValue(glb.Health)),
+				})
+				lastPool = serving
+			}
+			if unhealthy, ok := pools[unhealthyPoolID]; ok && (unhealthy.Health == nil || *unhealthy.Health != dns.Pool_Health_Critical) {
+				return glb, pdnsGLBFailoverPending, nil
+			}
+			if serving == poolID {
+				return glb, pdnsGLBFailoverCompleted, nil
+			}
+			return glb, pdnsGLBFailoverPending, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// pdnsGLBServingPool returns the pool that the global load balancer serves from: the first enabled
+// default pool whose health is reported and not critical, or the fallback pool. The health of the
+// origins is not evaluated here, a pool is reported as critical when it has less enabled and healthy
+// origins than its healthy origins threshold.
+func pdnsGLBServingPool(glb *dns.LoadBalancer, pools map[string]dns.Pool) string {
+	for _, id := range glb.DefaultPools {
+		pool, ok := pools[id]
+		if ok && (pool.Enabled == nil || *pool.Enabled) && pool.Health != nil && *pool.Health != dns.Pool_Health_Critical {
+			return id
+		}
+	}
+	if glb.FallbackPool == nil {
+		return ""
+	}
+	return *glb.FallbackPool
+}
+
+// pdnsGLBFailoverID returns the ID of a private DNS object from a Terraform ID, which may include the
+// IDs of the parent resources separated by slashes.
+func pdnsGLBFailoverID(id string) string {
+	return id[strings.LastIndex(id, "/")+1:]
+}
+
+func pdnsGLBFailoverTarget(poolID, originName string) string {
+	if originName == "" {
+		return fmt.Sprintf("the origins of pool '%s'", poolID)
+	}
+	return fmt.Sprintf("origin '%s' of pool '%s'", originName, poolID)
+}
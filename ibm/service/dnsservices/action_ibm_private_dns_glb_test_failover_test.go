@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPrivateDNSGlbTestFailoverActionBasic(t *testing.T) {
+	name := fmt.Sprintf("testpdnsglbfailover%d.com", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSGlbTestFailoverActionConfig(name, "ibm_dns_glb_pool.secondary.pool_id", "10m"),
+			},
+		},
+	})
+}
+
+func TestAccIBMPrivateDNSGlbTestFailoverActionInvalidTimeout(t *testing.T) {
+	name := fmt.Sprintf("testpdnsglbfailover%d.com", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPrivateDNSGlbTestFailoverActionConfig(name, "ibm_dns_glb_pool.secondary.pool_id", "ten minutes"),
+				ExpectError: regexp.MustCompile("Invalid Timeout Format"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSGlbTestFailoverActionConfig(name, expectedPool, timeout string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+
+	resource "ibm_is_vpc" "test-pdns-vpc" {
+		name           = "test-pdns-glb-failover-vpc"
+		resource_group = data.ibm_resource_group.rg.id
+	}
+
+	resource "ibm_is_subnet" "test-pdns-subnet" {
+		name            = "test-pdns-glb-failover-subnet"
+		vpc             = ibm_is_vpc.test-pdns-vpc.id
+		zone            = "us-south-1"
+		ipv4_cidr_block = "10.240.26.0/24"
+		resource_group  = data.ibm_resource_group.rg.id
+	}
+
+	resource "ibm_resource_instance" "test-pdns-instance" {
+		name              = "test-pdns-glb-failover-instance"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location          = "global"
+		service           = "dns-svcs"
+		plan              = "standard-dns"
+	}
+
+	resource "ibm_dns_zone" "test-pdns-zone" {
+		name        = "%[1]s"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		description = "testdescription"
+		label       = "testlabel"
+	}
+
+	resource "ibm_dns_glb_monitor" "test-pdns-monitor" {
+		depends_on  = [ibm_dns_zone.test-pdns-zone]
+		name        = "test-pdns-glb-failover-monitor"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		interval    = 60
+		retries     = 3
+		timeout     = 8
+		port        = 443
+		type        = "HTTPS"
+	}
+
+	resource "ibm_dns_glb_pool" "primary" {
+		name                      = "primary"
+		instance_id               = ibm_resource_instance.test-pdns-instance.guid
+		enabled                   = true
+		healthy_origins_threshold = 1
+		origins {
+			name    = "primary-1"
+			address = "www.google.com"
+			enabled = true
+		}
+		monitor             = ibm_dns_glb_monitor.test-pdns-monitor.monitor_id
+		healthcheck_region  = "us-south"
+		healthcheck_subnets = [ibm_is_subnet.test-pdns-subnet.resource_crn]
+	}
+
+	resource "ibm_dns_glb_pool" "secondary" {
+		name                      = "secondary"
+		instance_id               = ibm_resource_instance.test-pdns-instance.guid
+		enabled                   = true
+		healthy_origins_threshold = 1
+		origins {
+			name    = "secondary-1"
+			address = "www.ibm.com"
+			enabled = true
+		}
+		monitor             = ibm_dns_glb_monitor.test-pdns-monitor.monitor_id
+		healthcheck_region  = "us-south"
+		healthcheck_subnets = [ibm_is_subnet.test-pdns-subnet.resource_crn]
+	}
+
+	resource "ibm_dns_glb" "test-pdns-lb" {
+		name          = "test-failover"
+		instance_id   = ibm_resource_instance.test-pdns-instance.guid
+		zone_id       = ibm_dns_zone.test-pdns-zone.zone_id
+		ttl           = 120
+		fallback_pool = ibm_dns_glb_pool.secondary.pool_id
+		default_pools = [ibm_dns_glb_pool.primary.pool_id, ibm_dns_glb_pool.secondary.pool_id]
+	}
+
+	action "ibm_dns_glb_test_failover" "test" {
+		config {
+			instance_id      = ibm_resource_instance.test-pdns-instance.guid
+			zone_id          = ibm_dns_zone.test-pdns-zone.zone_id
+			glb_id           = ibm_dns_glb.test-pdns-lb.glb_id
+			pool_id          = ibm_dns_glb_pool.primary.pool_id
+			expected_pool_id = %[2]s
+			timeout          = "%[3]s"
+		}
+	}
+
+	resource "terraform_data" "test" {
+		input = ibm_dns_glb.test-pdns-lb.id
+
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_dns_glb_test_failover.test]
+			}
+		}
+	}
+	`, name, expectedPool, timeout)
+}
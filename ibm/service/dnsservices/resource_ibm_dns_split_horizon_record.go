@@ -0,0 +1,609 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	splitHorizonPublic      = "public"
+	splitHorizonPrivate     = "private"
+	splitHorizonContent     = "content"
+	splitHorizonRecordID    = "record_id"
+	splitHorizonProxied     = "proxied"
+	splitHorizonCisID       = "cis_id"
+	splitHorizonCisDomainID = "domain_id"
+)
+
+// splitHorizonRecordTypes are the record types that are supported by both CIS and Private DNS
+// with a single value.
+var splitHorizonRecordTypes = []string{"A", "AAAA", "CNAME", "TXT"}
+
+func ResourceIBMDNSSplitHorizonRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDNSSplitHorizonRecordCreate,
+		ReadContext:   resourceIBMDNSSplitHorizonRecordRead,
+		UpdateContext: resourceIBMDNSSplitHorizonRecordUpdate,
+		DeleteContext: resourceIBMDNSSplitHorizonRecordDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			pdnsRecordName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "DNS record name, relative to the CIS domain and the private DNS zones. Use @ for the apex",
+			},
+			pdnsRecordType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues(splitHorizonRecordTypes),
+				Description:  "DNS record type",
+			},
+			pdnsRecordTTL: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     900,
+				Description: "Default time to live of the record in every view",
+			},
+			splitHorizonPublic: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{splitHorizonPublic, splitHorizonPrivate},
+				Description:  "Value of the record in the public view, published in a CIS domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						splitHorizonCisID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "CIS instance CRN",
+						},
+						splitHorizonCisDomainID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "CIS domain ID",
+						},
+						splitHorizonContent: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Record content in the public view",
+						},
+						splitHorizonProxied: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the record is proxied by CIS",
+						},
+						pdnsRecordTTL: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Time to live of the record in the public view, defaults to the ttl argument",
+						},
+						splitHorizonRecordID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CIS DNS record ID",
+						},
+					},
+				},
+			},
+			splitHorizonPrivate: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Values of the record in the private views, one per private DNS zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsInstanceID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "DNS Services instance ID",
+						},
+						pdnsZoneID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Private DNS zone ID",
+						},
+						splitHorizonContent: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Record content in the private view",
+						},
+						pdnsRecordTTL: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Time to live of the record in the private view, defaults to the ttl argument",
+						},
+						splitHorizonRecordID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Private DNS resource record ID",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// splitHorizonView is the value of the record in one view, public or private.
+type splitHorizonView struct {
+	public     bool
+	instanceID string // CIS CRN of the public view, DNS Services instance of a private view
+	zoneID     string
+	content    string
+	ttl        int
+	ttlArg     int // ttl argument of the view, 0 when the view follows the ttl argument of the record
+	proxied    bool
+	recordID   string
+}
+
+func (v splitHorizonView) key() string {
+	if v.public {
+		return "public"
+	}
+	return fmt.Sprintf("private %s/%s", v.instanceID, v.zoneID)
+}
+
+func (v splitHorizonView) String() string {
+	if v.public {
+		return "the public view"
+	}
+	return fmt.Sprintf("the private view of zone %s", v.zoneID)
+}
+
+func expandSplitHorizonViews(publicViews, privateViews []interface{}, defaultTTL int) []splitHorizonView {
+	views := make([]splitHorizonView, 0)
+	for _, p := range publicViews {
+		m := p.(map[string]interface{})
+		zoneID, _, _ := flex.ConvertTftoCisTwoVar(m[splitHorizonCisDomainID].(string))
+		view := splitHorizonView{
+			public:     true,
+			instanceID: m[splitHorizonCisID].(string),
+			zoneID:     zoneID,
+			content:    m[splitHorizonContent].(string),
+			ttl:        m[pdnsRecordTTL].(int),
+			ttlArg:     m[pdnsRecordTTL].(int),
+			proxied:    m[splitHorizonProxied].(bool),
+			recordID:   m[splitHorizonRecordID].(string),
+		}
+		if view.ttl == 0 {
+			view.ttl = defaultTTL
+		}
+		views = append(views, view)
+	}
+	for _, p := range privateViews {
+		m := p.(map[string]interface{})
+		view := splitHorizonView{
+			instanceID: m[pdnsInstanceID].(string),
+			zoneID:     m[pdnsZoneID].(string),
+			content:    m[splitHorizonContent].(string),
+			ttl:        m[pdnsRecordTTL].(int),
+			ttlArg:     m[pdnsRecordTTL].(int),
+			recordID:   m[splitHorizonRecordID].(string),
+		}
+		if view.ttl == 0 {
+			view.ttl = defaultTTL
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+// setSplitHorizonViews sets the public and private views in the state.
+func setSplitHorizonViews(d *schema.ResourceData, views []splitHorizonView) {
+	publicViews := make([]map[string]interface{}, 0)
+	privateViews := make([]map[string]interface{}, 0)
+	for _, view := range views {
+		ttl := view.ttlArg
+		if view.public {
+			publicViews = append(publicViews, map[string]interface{}{
+				splitHorizonCisID:       view.instanceID,
+				splitHorizonCisDomainID: view.zoneID,
+				splitHorizonContent:     view.content,
+				splitHorizonProxied:     view.proxied,
+				pdnsRecordTTL:           ttl,
+				splitHorizonRecordID:    view.recordID,
+			})
+			continue
+		}
+		privateViews = append(privateViews, map[string]interface{}{
+			pdnsInstanceID:       view.instanceID,
+			pdnsZoneID:           view.zoneID,
+			splitHorizonContent:  view.content,
+			pdnsRecordTTL:        ttl,
+			splitHorizonRecordID: view.recordID,
+		})
+	}
+	d.Set(splitHorizonPublic, publicViews)
+	d.Set(splitHorizonPrivate, privateViews)
+}
+
+func resourceIBMDNSSplitHorizonRecordCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get(pdnsRecordName).(string)
+	recordType := d.Get(pdnsRecordType).(string)
+	views := expandSplitHorizonViews(d.Get(splitHorizonPublic).([]interface{}), d.Get(splitHorizonPrivate).([]interface{}), d.Get(pdnsRecordTTL).(int))
+
+	// The views are managed together, so the records created before a failure are deleted.
+	for i := range views {
+		recordID, err := createSplitHorizonViewRecord(meta, name, recordType, views[i])
+		if err != nil {
+			for _, created := range views[:i] {
+				if deleteErr := deleteSplitHorizonViewRecord(meta, created); deleteErr != nil {
+					log.Printf("[WARN] Error deleting the record of %s after a failed create: %s", created, deleteErr)
+				}
+			}
+			return diag.FromErr(err)
+		}
+		views[i].recordID = recordID
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	setSplitHorizonViews(d, views)
+	return resourceIBMDNSSplitHorizonRecordRead(context, d, meta)
+}
+
+func resourceIBMDNSSplitHorizonRecordRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	views := expandSplitHorizonViews(d.Get(splitHorizonPublic).([]interface{}), d.Get(splitHorizonPrivate).([]interface{}), d.Get(pdnsRecordTTL).(int))
+
+	// A view that changed outside of Terraform is reported as a warning, and the live value is
+	// stored in the state so that the plan restores the configured value.
+	for i, view := range views {
+		live, found, err := readSplitHorizonViewRecord(meta, view)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !found {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Split horizon record drifted",
+				Detail:   fmt.Sprintf("The record %s of %s was deleted outside of Terraform and will be created again.", view.recordID, view),
+			})
+			views[i].recordID = ""
+			views[i].content = ""
+			continue
+		}
+		var changes []string
+		if live.content != view.content {
+			changes = append(changes, fmt.Sprintf("content %q to %q", view.content, live.content))
+		}
+		if live.ttl != view.ttl && !(view.public && view.proxied) {
+			changes = append(changes, fmt.Sprintf("ttl %d to %d", view.ttl, live.ttl))
+		}
+		if live.proxied != view.proxied {
+			changes = append(changes, fmt.Sprintf("proxied %t to %t", view.proxied, live.proxied))
+		}
+		if len(changes) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Split horizon record drifted",
+				Detail:   fmt.Sprintf("The record %s of %s was changed outside of Terraform: %s.", view.recordID, view, strings.Join(changes, ", ")),
+			})
+			views[i].content = live.content
+			views[i].proxied = live.proxied
+			if live.ttl != view.ttl && !(view.public && view.proxied) {
+				views[i].ttl = live.ttl
+				views[i].ttlArg = live.ttl
+			}
+		}
+	}
+
+	setSplitHorizonViews(d, views)
+	return diags
+}
+
+func resourceIBMDNSSplitHorizonRecordUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get(pdnsRecordName).(string)
+	recordType := d.Get(pdnsRecordType).(string)
+	oldPublic, newPublic := d.GetChange(splitHorizonPublic)
+	oldPrivate, newPrivate := d.GetChange(splitHorizonPrivate)
+	oldTTL, newTTL := d.GetChange(pdnsRecordTTL)
+	oldViews := expandSplitHorizonViews(oldPublic.([]interface{}), oldPrivate.([]interface{}), oldTTL.(int))
+	newViews := expandSplitHorizonViews(newPublic.([]interface{}), newPrivate.([]interface{}), newTTL.(int))
+
+	oldByKey := map[string]splitHorizonView{}
+	for _, view := range oldViews {
+		oldByKey[view.key()] = view
+	}
+	newKeys := map[string]bool{}
+	for _, view := range newViews {
+		newKeys[view.key()] = true
+	}
+
+	// current holds the records that exist while the views are updated. When an update fails,
+	// it is stored in the state so that the records created by this apply are not orphaned and
+	// the records that this apply deleted are dropped from the state.
+	current := map[string]splitHorizonView{}
+	for _, view := range oldViews {
+		if view.recordID != "" {
+			current[view.key()] = view
+		}
+	}
+	failed := func(err error) diag.Diagnostics {
+		// The views keep the order of the configuration, followed by the views that were
+		// removed from it but could not be deleted
+		views := make([]splitHorizonView, 0, len(current))
+		for _, ordered := range [][]splitHorizonView{newViews, oldViews} {
+			for _, view := range ordered {
+				if c, ok := current[view.key()]; ok {
+					views = append(views, c)
+					delete(current, view.key())
+				}
+			}
+		}
+		setSplitHorizonViews(d, views)
+		return append(diag.FromErr(err), resourceIBMDNSSplitHorizonRecordRead(context, d, meta)...)
+	}
+
+	for _, view := range oldViews {
+		if newKeys[view.key()] || view.recordID == "" {
+			continue
+		}
+		if err := deleteSplitHorizonViewRecord(meta, view); err != nil {
+			return failed(err)
+		}
+		delete(current, view.key())
+	}
+
+	for i, view := range newViews {
+		old, ok := oldByKey[view.key()]
+		if !ok || old.recordID == "" {
+			recordID, err := createSplitHorizonViewRecord(meta, name, recordType, view)
+			if err != nil {
+				return failed(err)
+			}
+			newViews[i].recordID = recordID
+			current[view.key()] = newViews[i]
+			continue
+		}
+		newViews[i].recordID = old.recordID
+		if old.content == view.content && old.ttl == view.ttl && old.proxied == view.proxied {
+			current[view.key()] = newViews[i]
+			continue
+		}
+		if err := updateSplitHorizonViewRecord(meta, name, recordType, newViews[i]); err != nil {
+			return failed(err)
+		}
+		current[view.key()] = newViews[i]
+	}
+
+	setSplitHorizonViews(d, newViews)
+	return resourceIBMDNSSplitHorizonRecordRead(context, d, meta)
+}
+
+func resourceIBMDNSSplitHorizonRecordDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	views := expandSplitHorizonViews(d.Get(splitHorizonPublic).([]interface{}), d.Get(splitHorizonPrivate).([]interface{}), d.Get(pdnsRecordTTL).(int))
+	for _, view := range views {
+		if view.recordID == "" {
+			continue
+		}
+		if err := deleteSplitHorizonViewRecord(meta, view); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func splitHorizonCISSession(meta interface{}, view splitHorizonView) (*dnsrecordsv1.DnsRecordsV1, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(view.instanceID)
+	sess.ZoneIdentifier = core.StringPtr(view.zoneID)
+	return sess, nil
+}
+
+// splitHorizonPrivateRdata returns the rdata of a private DNS record of the given type.
+func splitHorizonPrivateRdata(sess *dnssvcsv1.DnsSvcsV1, recordType, content string) (dnssvcsv1.ResourceRecordInputRdataIntf, error) {
+	switch recordType {
+	case "A":
+		return sess.NewResourceRecordInputRdataRdataARecord(content)
+	case "AAAA":
+		return sess.NewResourceRecordInputRdataRdataAaaaRecord(content)
+	case "CNAME":
+		return sess.NewResourceRecordInputRdataRdataCnameRecord(content)
+	default:
+		return sess.NewResourceRecordInputRdataRdataTxtRecord(content)
+	}
+}
+
+func splitHorizonPrivateUpdateRdata(sess *dnssvcsv1.DnsSvcsV1, recordType, content string) (dnssvcsv1.ResourceRecordUpdateInputRdataIntf, error) {
+	switch recordType {
+	case "A":
+		return sess.NewResourceRecordUpdateInputRdataRdataARecord(content)
+	case "AAAA":
+		return sess.NewResourceRecordUpdateInputRdataRdataAaaaRecord(content)
+	case "CNAME":
+		return sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(content)
+	default:
+		return sess.NewResourceRecordUpdateInputRdataRdataTxtRecord(content)
+	}
+}
+
+func createSplitHorizonViewRecord(meta interface{}, name, recordType string, view splitHorizonView) (string, error) {
+	if view.public {
+		sess, err := splitHorizonCISSession(meta, view)
+		if err != nil {
+			return "", err
+		}
+		opt := sess.NewCreateDnsRecordOptions()
+		opt.SetName(name)
+		opt.SetType(recordType)
+		opt.SetContent(view.content)
+		opt.SetTTL(int64(view.ttl))
+		opt.SetProxied(view.proxied)
+		result, response, err := sess.CreateDnsRecord(opt)
+		if err != nil {
+			return "", flex.FmtErrorf("[ERROR] Error creating the record of %s: %s\n%s", view, err, response)
+		}
+		return *result.Result.ID, nil
+	}
+
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return "", err
+	}
+	rdata, err := splitHorizonPrivateRdata(sess, recordType, view.content)
+	if err != nil {
+		return "", flex.FmtErrorf("[ERROR] Error creating the record data of %s: %s", view, err)
+	}
+	opt := sess.NewCreateResourceRecordOptions(view.instanceID, view.zoneID, recordType)
+	opt.SetName(name)
+	opt.SetTTL(int64(view.ttl))
+	opt.SetRdata(rdata)
+	mk := "private_dns_resource_record_" + view.instanceID + view.zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+	result, response, err := sess.CreateResourceRecord(opt)
+	if err != nil {
+		return "", flex.FmtErrorf("[ERROR] Error creating the record of %s: %s\n%s", view, err, response)
+	}
+	return *result.ID, nil
+}
+
+func updateSplitHorizonViewRecord(meta interface{}, name, recordType string, view splitHorizonView) error {
+	if view.public {
+		sess, err := splitHorizonCISSession(meta, view)
+		if err != nil {
+			return err
+		}
+		opt := sess.NewUpdateDnsRecordOptions(view.recordID)
+		opt.SetName(name)
+		opt.SetType(recordType)
+		opt.SetContent(view.content)
+		opt.SetTTL(int64(view.ttl))
+		opt.SetProxied(view.proxied)
+		if _, response, err := sess.UpdateDnsRecord(opt); err != nil {
+			return flex.FmtErrorf("[ERROR] Error updating the record of %s: %s\n%s", view, err, response)
+		}
+		return nil
+	}
+
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	rdata, err := splitHorizonPrivateUpdateRdata(sess, recordType, view.content)
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error creating the record data of %s: %s", view, err)
+	}
+	opt := sess.NewUpdateResourceRecordOptions(view.instanceID, view.zoneID, view.recordID, "", nil)
+	opt.SetName(name)
+	opt.SetTTL(int64(view.ttl))
+	opt.SetRdata(rdata)
+	mk := "private_dns_resource_record_" + view.instanceID + view.zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+	if _, response, err := sess.UpdateResourceRecord(opt); err != nil {
+		return flex.FmtErrorf("[ERROR] Error updating the record of %s: %s\n%s", view, err, response)
+	}
+	return nil
+}
+
+// readSplitHorizonViewRecord reads the live value of the record of a view. found is false when the
+// record no longer exists.
+func readSplitHorizonViewRecord(meta interface{}, view splitHorizonView) (live splitHorizonView, found bool, err error) {
+	live = view
+	if view.public {
+		sess, err := splitHorizonCISSession(meta, view)
+		if err != nil {
+			return live, false, err
+		}
+		result, response, err := sess.GetDnsRecord(sess.NewGetDnsRecordOptions(view.recordID))
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return live, false, nil
+			}
+			return live, false, flex.FmtErrorf("[ERROR] Error reading the record of %s: %s\n%s", view, err, response)
+		}
+		if result.Result.Content != nil {
+			live.content = *result.Result.Content
+		}
+		if result.Result.TTL != nil {
+			live.ttl = int(*result.Result.TTL)
+		}
+		if result.Result.Proxied != nil {
+			live.proxied = *result.Result.Proxied
+		}
+		return live, true, nil
+	}
+
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return live, false, err
+	}
+	result, response, err := sess.GetResourceRecord(sess.NewGetResourceRecordOptions(view.instanceID, view.zoneID, view.recordID))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return live, false, nil
+		}
+		return live, false, flex.FmtErrorf("[ERROR] Error reading the record of %s: %s\n%s", view, err, response)
+	}
+	for _, key := range []string{"ip", "cname", "text"} {
+		if value, ok := result.Rdata[key].(string); ok {
+			live.content = value
+		}
+	}
+	if result.TTL != nil {
+		live.ttl = int(*result.TTL)
+	}
+	return live, true, nil
+}
+
+func deleteSplitHorizonViewRecord(meta interface{}, view splitHorizonView) error {
+	if view.public {
+		sess, err := splitHorizonCISSession(meta, view)
+		if err != nil {
+			return err
+		}
+		_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(view.recordID))
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return flex.FmtErrorf("[ERROR] Error deleting the record of %s: %s\n%s", view, err, response)
+		}
+		return nil
+	}
+
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	mk := "private_dns_resource_record_" + view.instanceID + view.zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+	response, err := sess.DeleteResourceRecord(sess.NewDeleteResourceRecordOptions(view.instanceID, view.zoneID, view.recordID))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return flex.FmtErrorf("[ERROR] Error deleting the record of %s: %s\n%s", view, err, response)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDNSSplitHorizonRecord_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-split-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDNSSplitHorizonRecordConfig(name, "192.0.2.10", "10.240.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dns_split_horizon_record.test", "type", "A"),
+					resource.TestCheckResourceAttr("ibm_dns_split_horizon_record.test", "public.0.content", "192.0.2.10"),
+					resource.TestCheckResourceAttrSet("ibm_dns_split_horizon_record.test", "public.0.record_id"),
+					resource.TestCheckResourceAttr("ibm_dns_split_horizon_record.test", "private.0.content", "10.240.0.10"),
+					resource.TestCheckResourceAttrSet("ibm_dns_split_horizon_record.test", "private.0.record_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDNSSplitHorizonRecordConfig(name, "192.0.2.11", "10.240.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dns_split_horizon_record.test", "public.0.content", "192.0.2.11"),
+					resource.TestCheckResourceAttr("ibm_dns_split_horizon_record.test", "private.0.content", "10.240.0.11"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDNSSplitHorizonRecordConfig(name, publicIP, privateIP string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default = true
+	}
+
+	data "ibm_cis" "cis" {
+		name              = "%[1]s"
+		resource_group_id = data.ibm_resource_group.rg.id
+	}
+
+	data "ibm_cis_domain" "cis_domain" {
+		cis_id = data.ibm_cis.cis.id
+		domain = "%[2]s"
+	}
+
+	resource "ibm_resource_instance" "test-pdns-instance" {
+		name              = "test-split-horizon-instance"
+		resource_group_id = data.ibm_resource_group.rg.id
+		location          = "global"
+		service           = "dns-svcs"
+		plan              = "standard-dns"
+	}
+
+	resource "ibm_dns_zone" "test-pdns-zone" {
+		name        = "%[2]s"
+		instance_id = ibm_resource_instance.test-pdns-instance.guid
+		description = "split horizon test zone"
+	}
+
+	resource "ibm_dns_split_horizon_record" "test" {
+		name = "%[3]s"
+		type = "A"
+		ttl  = 300
+
+		public {
+			cis_id    = data.ibm_cis.cis.id
+			domain_id = data.ibm_cis_domain.cis_domain.domain_id
+			content   = "%[4]s"
+		}
+
+		private {
+			instance_id = ibm_resource_instance.test-pdns-instance.guid
+			zone_id     = ibm_dns_zone.test-pdns-zone.zone_id
+			content     = "%[5]s"
+		}
+	}
+	`, acc.CisInstance, acc.CisDomainStatic, name, publicIP, privateIP)
+}
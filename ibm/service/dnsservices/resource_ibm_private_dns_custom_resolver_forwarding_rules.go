@@ -0,0 +1,563 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pdnsCRForwardRulesResource   = "ibm_dns_custom_resolver_forwarding_rules"
+	pdnsCRFRAddressClass         = "forward_to_address_class"
+	pdnsCRFRAllowNestedMatches   = "allow_nested_matches"
+	pdnsCRFRAddressClassAny      = "any"
+	pdnsCRFRAddressClassPrivate  = "private"
+	pdnsCRFRAddressClassPublic   = "public"
+	pdnsCRFRAddressClassInvalid  = "unreachable"
+	pdnsCRFRTypeZone             = "zone"
+	pdnsCRFRTypeHostname         = "hostname"
+	pdnsCRForwardRulesPageLimit  = 200
+	pdnsCRForwardRulesMutexKey   = "private_dns_custom_resolver_forwarding_rules_"
+	pdnsCRForwardRulesErrorMatch = "%s: match %q of rule %d"
+)
+
+// Address ranges that a custom resolver reaches over the private network, in addition to the
+// private ranges of net.IP.IsPrivate. 100.64.0.0/10 is shared address space, and 161.26.0.0/16
+// and 166.8.0.0/14 are the IBM Cloud service networks.
+var pdnsCRFRPrivateRanges = []string{"100.64.0.0/10", "161.26.0.0/16", "166.8.0.0/14"}
+
+func ResourceIBMPrivateDNSForwardingRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmDnsCrForwardingRulesCreate,
+		ReadContext:   resourceIbmDnsCrForwardingRulesRead,
+		UpdateContext: resourceIbmDnsCrForwardingRulesUpdate,
+		DeleteContext: resourceIbmDnsCrForwardingRulesDelete,
+		CustomizeDiff: resourceIbmDnsCrForwardingRulesCustomizeDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of a service instance.",
+			},
+			pdnsCRFRResolverID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of a custom resolver.",
+			},
+			pdnsCRFRAddressClass: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      pdnsCRFRAddressClassAny,
+				ValidateFunc: validate.InvokeValidator(pdnsCRForwardRulesResource, pdnsCRFRAddressClass),
+				Description:  "The address class that the upstream DNS servers must belong to: private, public or any.",
+			},
+			pdnsCRFRAllowNestedMatches: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow a rule to match a subdomain of the match of another rule.",
+			},
+			pdnsCRForwardRules: {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The forwarding rules of the custom resolver. Rules that are not in the list, except the default rule, are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsCRFRRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the forwarding rule.",
+						},
+						pdnsCRFRDesctiption: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Descriptive text of the forwarding rule.",
+						},
+						pdnsCRFRType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      pdnsCRFRTypeZone,
+							ValidateFunc: validate.InvokeValidator(pdnsCRForwardRulesResource, pdnsCRFRType),
+							Description:  "Type of the forwarding rule.",
+						},
+						pdnsCRFRMatch: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The matching zone or hostname.",
+						},
+						pdnsCRFRForwardTo: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The upstream DNS servers will be forwarded to.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						pdnsCRFRViews: {
+							Type:        schema.TypeList,
+							Description: "An array of views used by forwarding rules.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									pdnsCRFRVName: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Unique name of the view.",
+									},
+									pdnsCRFRVDescription: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Description of the view.",
+									},
+									pdnsCRFRVExpression: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Expression of the view.",
+									},
+									pdnsCRFRVForwardTo: {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "The upstream DNS servers that the matching DNS queries will be forwarded to.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMPrivateDNSForwardingRulesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 pdnsCRFRType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "zone, hostname",
+		},
+		validate.ValidateSchema{
+			Identifier:                 pdnsCRFRAddressClass,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "any, private, public",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: pdnsCRForwardRulesResource, Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIbmDnsCrForwardingRulesCustomizeDiff validates the rules at plan time. Values that are not
+// known yet are validated during apply.
+func resourceIbmDnsCrForwardingRulesCustomizeDiff(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(pdnsCRForwardRules) {
+		return nil
+	}
+	rules := d.Get(pdnsCRForwardRules).([]interface{})
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// Unknown upstream DNS servers read as empty, use an empty address that is not validated
+		if !d.NewValueKnown(fmt.Sprintf("%s.%d.%s", pdnsCRForwardRules, i, pdnsCRFRForwardTo)) ||
+			!d.NewValueKnown(fmt.Sprintf("%s.%d.%s", pdnsCRForwardRules, i, pdnsCRFRViews)) {
+			rule[pdnsCRFRForwardTo] = append(rule[pdnsCRFRForwardTo].([]interface{}), "")
+		}
+	}
+	return validatePDNSForwardingRules(rules, d.Get(pdnsCRFRAddressClass).(string), d.Get(pdnsCRFRAllowNestedMatches).(bool))
+}
+
+// validatePDNSForwardingRules checks that the matches of the rules are distinct, that no match is a
+// subdomain of another one unless allowNested is set, and that the upstream DNS servers are IP
+// addresses of the address class.
+func validatePDNSForwardingRules(rules []interface{}, addressClass string, allowNested bool) error {
+	matches := make(map[string]int, len(rules))
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		match := normalizePDNSForwardingRuleMatch(rule[pdnsCRFRMatch].(string))
+		if match == "" {
+			continue
+		}
+		if j, ok := matches[match]; ok {
+			return fmt.Errorf("[ERROR] Rules %d and %d of %s have the same match %q", j, i, pdnsCRForwardRulesResource, match)
+		}
+		matches[match] = i
+
+		forwardTo := rule[pdnsCRFRForwardTo].([]interface{})
+		views := rule[pdnsCRFRViews].([]interface{})
+		if len(forwardTo) == 0 && len(views) == 0 {
+			return fmt.Errorf("[ERROR] One of forward_to or views must be provided for the match %q of rule %d", match, i)
+		}
+		for _, v := range views {
+			view, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			forwardTo = append(forwardTo, view[pdnsCRFRVForwardTo].([]interface{})...)
+		}
+		for _, f := range forwardTo {
+			address, _ := f.(string)
+			if address == "" {
+				continue
+			}
+			class := pdnsForwardingAddressClass(address)
+			if class == pdnsCRFRAddressClassInvalid {
+				return fmt.Errorf("[ERROR] The upstream DNS server %q of the match %q of rule %d is not a reachable IP address", address, match, i)
+			}
+			if addressClass != pdnsCRFRAddressClassAny && class != addressClass {
+				return fmt.Errorf("[ERROR] The upstream DNS server %q of the match %q of rule %d is a %s address, %s requires %s addresses", address, match, i, class, pdnsCRFRAddressClass, addressClass)
+			}
+		}
+	}
+
+	if allowNested {
+		return nil
+	}
+	for match, i := range matches {
+		labels := strings.Split(match, ".")
+		for k := 1; k < len(labels); k++ {
+			parent := strings.Join(labels[k:], ".")
+			if j, ok := matches[parent]; ok {
+				return fmt.Errorf("[ERROR] The match %q of rule %d is a subdomain of the match %q of rule %d. Set %s to allow nested matches", match, i, parent, j, pdnsCRFRAllowNestedMatches)
+			}
+		}
+	}
+	return nil
+}
+
+func normalizePDNSForwardingRuleMatch(match string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(match)), ".")
+}
+
+// pdnsForwardingAddressClass returns the address class of an upstream DNS server, which may include
+// a port.
+func pdnsForwardingAddressClass(address string) string {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			ip = net.ParseIP(host)
+		}
+	}
+	if ip == nil || ip.IsUnspecified() || ip.IsLoopback() || ip.IsMulticast() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return pdnsCRFRAddressClassInvalid
+	}
+	if ip.IsPrivate() {
+		return pdnsCRFRAddressClassPrivate
+	}
+	for _, r := range pdnsCRFRPrivateRanges {
+		if _, network, err := net.ParseCIDR(r); err == nil && network.Contains(ip) {
+			return pdnsCRFRAddressClassPrivate
+		}
+	}
+	return pdnsCRFRAddressClassPublic
+}
+
+func resourceIbmDnsCrForwardingRulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIbmDnsCrForwardingRulesCreate Client initialization failed: %s", err.Error()), pdnsCRForwardRulesResource, "create")
+		return tfErr.GetDiag()
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	resolverID := d.Get(pdnsCRFRResolverID).(string)
+
+	// The resource deletes the rules that are not in the configuration, which must not include rules
+	// that are managed elsewhere, for example by ibm_dns_custom_resolver_forwarding_rule.
+	existing, err := listPDNSForwardingRules(context, sess, instanceID, resolverID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, "create")
+		return tfErr.GetDiag()
+	}
+	if len(existing) > 0 {
+		matches := make([]string, 0, len(existing))
+		for _, rule := range existing {
+			matches = append(matches, *rule.Match)
+		}
+		err := fmt.Errorf("[ERROR] Custom resolver %s already has forwarding rules for %s. Import the resource, or delete the rules, before creating %s", resolverID, strings.Join(matches, ", "), pdnsCRForwardRulesResource)
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, "create")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(resolverID, instanceID))
+
+	if diags := reconcilePDNSForwardingRules(context, d, meta, "create"); diags != nil {
+		// Keep the rules that were created before the failure in the state
+		return append(diags, resourceIbmDnsCrForwardingRulesRead(context, d, meta)...)
+	}
+	return resourceIbmDnsCrForwardingRulesRead(context, d, meta)
+}
+
+func resourceIbmDnsCrForwardingRulesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(pdnsCRForwardRules) {
+		if diags := reconcilePDNSForwardingRules(context, d, meta, "update"); diags != nil {
+			return diags
+		}
+	}
+	return resourceIbmDnsCrForwardingRulesRead(context, d, meta)
+}
+
+// reconcilePDNSForwardingRules makes the forwarding rules of the custom resolver match the configured
+// rules. Rules are matched by their match, so that a rule is updated in place when only its
+// forwarders or views change.
+func reconcilePDNSForwardingRules(context context.Context, d *schema.ResourceData, meta interface{}, operation string) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("reconcilePDNSForwardingRules Client initialization failed: %s", err.Error()), pdnsCRForwardRulesResource, operation)
+		return tfErr.GetDiag()
+	}
+	resolverID, instanceID, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, operation)
+		return tfErr.GetDiag()
+	}
+
+	rules := d.Get(pdnsCRForwardRules).([]interface{})
+	if err := validatePDNSForwardingRules(rules, d.Get(pdnsCRFRAddressClass).(string), d.Get(pdnsCRFRAllowNestedMatches).(bool)); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, operation)
+		return tfErr.GetDiag()
+	}
+
+	mk := pdnsCRForwardRulesMutexKey + instanceID + resolverID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	existing, err := listPDNSForwardingRules(context, sess, instanceID, resolverID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, operation)
+		return tfErr.GetDiag()
+	}
+	byMatch := make(map[string]dns.ForwardingRule, len(existing))
+	for _, rule := range existing {
+		byMatch[normalizePDNSForwardingRuleMatch(*rule.Match)] = rule
+	}
+
+	desired := make(map[string]bool, len(rules))
+	for i, r := range rules {
+		rule := r.(map[string]interface{})
+		ruleType := rule[pdnsCRFRType].(string)
+		match := rule[pdnsCRFRMatch].(string)
+		description := rule[pdnsCRFRDesctiption].(string)
+		forwardTo := flex.ExpandStringList(rule[pdnsCRFRForwardTo].([]interface{}))
+		views := expandPDNSFRViews(rule[pdnsCRFRViews].([]interface{}))
+		key := normalizePDNSForwardingRuleMatch(match)
+		desired[key] = true
+
+		current, ok := byMatch[key]
+		if ok && current.Type != nil && *current.Type == ruleType {
+			if pdnsForwardingRuleUpToDate(current, description, forwardTo, views) {
+				continue
+			}
+			opt := sess.NewUpdateForwardingRuleOptions(instanceID, resolverID, *current.ID)
+			opt.SetDescription(description)
+			opt.SetForwardTo(forwardTo)
+			opt.SetViews(views)
+			if _, resp, err := sess.UpdateForwardingRuleWithContext(context, opt); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateForwardingRuleWithContext failed for rule %d with error: %s and response:\n%s", i, err, resp), pdnsCRForwardRulesResource, operation)
+				return tfErr.GetDiag()
+			}
+			continue
+		}
+		if ok {
+			// The type of a rule cannot be updated
+			opt := sess.NewDeleteForwardingRuleOptions(instanceID, resolverID, *current.ID)
+			if resp, err := sess.DeleteForwardingRuleWithContext(context, opt); err != nil && (resp == nil || resp.StatusCode != 404) {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteForwardingRuleWithContext failed for rule %d with error: %s and response:\n%s", i, err, resp), pdnsCRForwardRulesResource, operation)
+				return tfErr.GetDiag()
+			}
+		}
+
+		var input dns.ForwardingRuleInputIntf
+		switch {
+		case len(forwardTo) > 0 && len(views) > 0:
+			both, _ := sess.NewForwardingRuleInputForwardingRuleBoth(ruleType, match, forwardTo, views)
+			both.Description = core.StringPtr(description)
+			input = both
+		case len(forwardTo) > 0:
+			onlyForward, _ := sess.NewForwardingRuleInputForwardingRuleOnlyForward(ruleType, match, forwardTo)
+			onlyForward.Description = core.StringPtr(description)
+			input = onlyForward
+		default:
+			onlyView, _ := sess.NewForwardingRuleInputForwardingRuleOnlyView(ruleType, match, views)
+			onlyView.Description = core.StringPtr(description)
+			input = onlyView
+		}
+		opt := sess.NewCreateForwardingRuleOptions(instanceID, resolverID, input)
+		if _, resp, err := sess.CreateForwardingRuleWithContext(context, opt); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateForwardingRuleWithContext failed for rule %d with error: %s and response:\n%s", i, err, resp), pdnsCRForwardRulesResource, operation)
+			return tfErr.GetDiag()
+		}
+	}
+
+	for key, rule := range byMatch {
+		if desired[key] {
+			continue
+		}
+		opt := sess.NewDeleteForwardingRuleOptions(instanceID, resolverID, *rule.ID)
+		if resp, err := sess.DeleteForwardingRuleWithContext(context, opt); err != nil && (resp == nil || resp.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteForwardingRuleWithContext failed for match %s with error: %s and response:\n%s", *rule.Match, err, resp), pdnsCRForwardRulesResource, operation)
+			return tfErr.GetDiag()
+		}
+	}
+	return nil
+}
+
+func pdnsForwardingRuleUpToDate(rule dns.ForwardingRule, description string, forwardTo []string, views []dns.ViewConfig) bool {
+	if flex.StringValue(rule.Description) != description || strings.Join(rule.ForwardTo, ",") != strings.Join(forwardTo, ",") || len(rule.Views) != len(views) {
+		return false
+	}
+	for i, view := range rule.Views {
+		want := views[i]
+		if flex.StringValue(view.Name) != *want.Name ||
+			flex.StringValue(view.Description) != *want.Description ||
+			flex.StringValue(view.Expression) != *want.Expression ||
+			strings.Join(view.ForwardTo, ",") != strings.Join(want.ForwardTo, ",") {
+			return false
+		}
+	}
+	return true
+}
+
+// listPDNSForwardingRules returns the forwarding rules of a custom resolver, except the default rule.
+func listPDNSForwardingRules(context context.Context, sess *dns.DnsSvcsV1, instanceID, resolverID string) ([]dns.ForwardingRule, error) {
+	rules := make([]dns.ForwardingRule, 0)
+	opt := sess.NewListForwardingRulesOptions(instanceID, resolverID)
+	opt.SetLimit(pdnsCRForwardRulesPageLimit)
+	offset := int64(0)
+	for {
+		opt.SetOffset(offset)
+		result, resp, err := sess.ListForwardingRulesWithContext(context, opt)
+		if err != nil || result == nil {
+			return nil, fmt.Errorf("ListForwardingRulesWithContext failed with error: %s and response:\n%s", err, resp)
+		}
+		for _, rule := range result.ForwardingRules {
+			if rule.ID == nil || rule.Match == nil || (rule.Type != nil && *rule.Type == dns.ForwardingRule_Type_Default) {
+				continue
+			}
+			rules = append(rules, rule)
+		}
+		offset += int64(len(result.ForwardingRules))
+		if len(result.ForwardingRules) == 0 || result.TotalCount == nil || offset >= *result.TotalCount {
+			break
+		}
+	}
+	return rules, nil
+}
+
+func resourceIbmDnsCrForwardingRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIbmDnsCrForwardingRulesRead Client initialization failed: %s", err.Error()), pdnsCRForwardRulesResource, "read")
+		return tfErr.GetDiag()
+	}
+	resolverID, instanceID, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, "read")
+		return tfErr.GetDiag()
+	}
+
+	existing, err := listPDNSForwardingRules(context, sess, instanceID, resolverID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, "read")
+		return tfErr.GetDiag()
+	}
+
+	// Keep the order of the configuration, rules that are not configured are added at the end
+	position := map[string]int{}
+	for i, r := range d.Get(pdnsCRForwardRules).([]interface{}) {
+		if rule, ok := r.(map[string]interface{}); ok {
+			position[normalizePDNSForwardingRuleMatch(rule[pdnsCRFRMatch].(string))] = i
+		}
+	}
+	ordered := make([]map[string]interface{}, len(position))
+	extra := make([]map[string]interface{}, 0)
+	for _, rule := range existing {
+		forwardRule := map[string]interface{}{
+			pdnsCRFRRuleID:      *rule.ID,
+			pdnsCRFRDesctiption: flex.StringValue(rule.Description),
+			pdnsCRFRType:        flex.StringValue(rule.Type),
+			pdnsCRFRMatch:       *rule.Match,
+			pdnsCRFRForwardTo:   rule.ForwardTo,
+			pdnsCRFRViews:       flattenPDNSFRViews(rule.Views),
+		}
+		if i, ok := position[normalizePDNSForwardingRuleMatch(*rule.Match)]; ok && ordered[i] == nil {
+			ordered[i] = forwardRule
+		} else {
+			extra = append(extra, forwardRule)
+		}
+	}
+	forwardRules := make([]map[string]interface{}, 0, len(existing))
+	for _, rule := range ordered {
+		if rule != nil {
+			forwardRules = append(forwardRules, rule)
+		}
+	}
+	forwardRules = append(forwardRules, extra...)
+
+	d.Set(pdnsInstanceID, instanceID)
+	d.Set(pdnsCRFRResolverID, resolverID)
+	if _, ok := d.GetOk(pdnsCRFRAddressClass); !ok {
+		d.Set(pdnsCRFRAddressClass, pdnsCRFRAddressClassAny)
+	}
+	d.Set(pdnsCRForwardRules, forwardRules)
+	return nil
+}
+
+func resourceIbmDnsCrForwardingRulesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceIbmDnsCrForwardingRulesDelete Client initialization failed: %s", err.Error()), pdnsCRForwardRulesResource, "delete")
+		return tfErr.GetDiag()
+	}
+	resolverID, instanceID, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), pdnsCRForwardRulesResource, "delete")
+		return tfErr.GetDiag()
+	}
+
+	mk := pdnsCRForwardRulesMutexKey + instanceID + resolverID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	for _, r := range d.Get(pdnsCRForwardRules).([]interface{}) {
+		rule := r.(map[string]interface{})
+		ruleID := rule[pdnsCRFRRuleID].(string)
+		if ruleID == "" {
+			continue
+		}
+		opt := sess.NewDeleteForwardingRuleOptions(instanceID, resolverID, ruleID)
+		response, err := sess.DeleteForwardingRuleWithContext(context, opt)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteForwardingRuleWithContext failed: %s", err.Error()), pdnsCRForwardRulesResource, "delete")
+			return tfErr.GetDiag()
+		}
+	}
+	d.SetId("")
+	return nil
+}
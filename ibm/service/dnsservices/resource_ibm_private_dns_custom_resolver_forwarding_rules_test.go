@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPrivateDNSCustomResolverForwardingRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("frs-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("frs-subnet-name-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_dns_custom_resolver_forwarding_rules.rules"
+	rules := `
+		rules {
+			match      = "example.com"
+			forward_to = ["10.240.2.1"]
+		}
+		rules {
+			match      = "test.example.org"
+			type       = "hostname"
+			forward_to = ["161.26.0.10"]
+		}`
+	updatedRules := `
+		rules {
+			match       = "example.com"
+			description = "Updated rule"
+			forward_to  = ["10.240.2.2"]
+		}
+		rules {
+			match      = "example.net"
+			forward_to = ["10.240.2.1"]
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmDnsCrForwardingRulesConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, "private", rules),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.#", "2"),
+					resource.TestCheckResourceAttr(name, "rules.0.match", "example.com"),
+					resource.TestCheckResourceAttr(name, "rules.1.type", "hostname"),
+					resource.TestCheckResourceAttrSet(name, "rules.0.rule_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmDnsCrForwardingRulesConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, "private", updatedRules),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.#", "2"),
+					resource.TestCheckResourceAttr(name, "rules.0.description", "Updated rule"),
+					resource.TestCheckResourceAttr(name, "rules.0.forward_to.0", "10.240.2.2"),
+					resource.TestCheckResourceAttr(name, "rules.1.match", "example.net"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"forward_to_address_class", "allow_nested_matches"},
+			},
+		},
+	})
+}
+
+func TestAccIBMPrivateDNSCustomResolverForwardingRules_invalid(t *testing.T) {
+	vpcname := fmt.Sprintf("frs-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("frs-subnet-name-%d", acctest.RandIntRange(10, 100))
+	duplicateRules := `
+		rules {
+			match      = "example.com"
+			forward_to = ["10.240.2.1"]
+		}
+		rules {
+			match      = "Example.com."
+			forward_to = ["10.240.2.2"]
+		}`
+	nestedRules := `
+		rules {
+			match      = "example.com"
+			forward_to = ["10.240.2.1"]
+		}
+		rules {
+			match      = "dev.example.com"
+			forward_to = ["10.240.2.2"]
+		}`
+	publicRules := `
+		rules {
+			match      = "example.com"
+			forward_to = ["8.8.8.8"]
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmDnsCrForwardingRulesConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, "any", duplicateRules),
+				ExpectError: regexp.MustCompile("have the same match"),
+			},
+			{
+				Config:      testAccCheckIbmDnsCrForwardingRulesConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, "any", nestedRules),
+				ExpectError: regexp.MustCompile("is a subdomain of the match"),
+			},
+			{
+				Config:      testAccCheckIbmDnsCrForwardingRulesConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, "private", publicRules),
+				ExpectError: regexp.MustCompile("is a public address"),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmDnsCrForwardingRulesConfig(vpcname, subnetname, zone, cidr, addressClass, rules string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "rg" {
+		is_default	= true
+	}
+	resource "ibm_is_vpc" "test-pdns-cr-vpc" {
+		name			= "%s"
+		resource_group	= data.ibm_resource_group.rg.id
+	}
+	resource "ibm_is_subnet" "test-pdns-cr-subnet1" {
+		name			= "%s"
+		vpc				= ibm_is_vpc.test-pdns-cr-vpc.id
+		zone			= "%s"
+		ipv4_cidr_block	= "%s"
+		resource_group	= data.ibm_resource_group.rg.id
+	}
+	resource "ibm_resource_instance" "test-pdns-cr-instance" {
+		name				= "test-pdns-cr-instance"
+		resource_group_id	= data.ibm_resource_group.rg.id
+		location			= "global"
+		service				= "dns-svcs"
+		plan				= "standard-dns"
+	}
+	resource "ibm_dns_custom_resolver" "test" {
+		name		= "testpdnscustomresolver"
+		instance_id = ibm_resource_instance.test-pdns-cr-instance.guid
+		description = "new test CR - TF"
+		high_availability = false
+		enabled 	= true
+		locations {
+			subnet_crn	= ibm_is_subnet.test-pdns-cr-subnet1.crn
+			enabled		= true
+		}
+	}
+	resource "ibm_dns_custom_resolver_forwarding_rules" "rules" {
+		instance_id              = ibm_resource_instance.test-pdns-cr-instance.guid
+		resolver_id              = ibm_dns_custom_resolver.test.custom_resolver_id
+		forward_to_address_class = "%s"
+		%s
+	}
+	`, vpcname, subnetname, zone, cidr, addressClass, rules)
+}
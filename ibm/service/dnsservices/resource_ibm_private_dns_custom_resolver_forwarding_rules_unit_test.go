@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPDNSForwardingAddressClass(t *testing.T) {
+	cases := map[string]string{
+		"10.0.0.1":              pdnsCRFRAddressClassPrivate,
+		"192.168.1.1:53":        pdnsCRFRAddressClassPrivate,
+		"100.64.0.10":           pdnsCRFRAddressClassPrivate,
+		"161.26.0.10":           pdnsCRFRAddressClassPrivate,
+		"166.9.0.1":             pdnsCRFRAddressClassPrivate,
+		"fd00::1":               pdnsCRFRAddressClassPrivate,
+		"8.8.8.8":               pdnsCRFRAddressClassPublic,
+		"[2001:4860::8888]:53":  pdnsCRFRAddressClassPublic,
+		"127.0.0.1":             pdnsCRFRAddressClassInvalid,
+		"0.0.0.0":               pdnsCRFRAddressClassInvalid,
+		"169.254.0.1":           pdnsCRFRAddressClassInvalid,
+		"224.0.0.1":             pdnsCRFRAddressClassInvalid,
+		"dns.example.com":       pdnsCRFRAddressClassInvalid,
+		"dns.example.com:53":    pdnsCRFRAddressClassInvalid,
+		"not an address at all": pdnsCRFRAddressClassInvalid,
+	}
+	for address, want := range cases {
+		if got := pdnsForwardingAddressClass(address); got != want {
+			t.Errorf("pdnsForwardingAddressClass(%q) = %q, want %q", address, got, want)
+		}
+	}
+}
+
+func TestValidatePDNSForwardingRules(t *testing.T) {
+	rule := func(match string, forwardTo ...string) interface{} {
+		f := make([]interface{}, 0, len(forwardTo))
+		for _, address := range forwardTo {
+			f = append(f, address)
+		}
+		return map[string]interface{}{
+			pdnsCRFRMatch:     match,
+			pdnsCRFRForwardTo: f,
+			pdnsCRFRViews:     []interface{}{},
+		}
+	}
+	view := func(match string, forwardTo ...string) interface{} {
+		r := rule(match).(map[string]interface{})
+		f := make([]interface{}, 0, len(forwardTo))
+		for _, address := range forwardTo {
+			f = append(f, address)
+		}
+		r[pdnsCRFRViews] = []interface{}{map[string]interface{}{pdnsCRFRVForwardTo: f}}
+		return r
+	}
+
+	cases := []struct {
+		name         string
+		rules        []interface{}
+		addressClass string
+		allowNested  bool
+		err          string
+	}{
+		{
+			name:         "distinct",
+			rules:        []interface{}{rule("example.com", "10.0.0.1"), rule("example.org", "8.8.8.8")},
+			addressClass: pdnsCRFRAddressClassAny,
+		},
+		{
+			name:         "duplicate",
+			rules:        []interface{}{rule("example.com", "10.0.0.1"), rule("Example.COM.", "10.0.0.2")},
+			addressClass: pdnsCRFRAddressClassAny,
+			err:          "have the same match",
+		},
+		{
+			name:         "nested",
+			rules:        []interface{}{rule("example.com", "10.0.0.1"), rule("app.example.com", "10.0.0.2")},
+			addressClass: pdnsCRFRAddressClassAny,
+			err:          "is a subdomain of",
+		},
+		{
+			name:         "nested allowed",
+			rules:        []interface{}{rule("example.com", "10.0.0.1"), rule("app.example.com", "10.0.0.2")},
+			addressClass: pdnsCRFRAddressClassAny,
+			allowNested:  true,
+		},
+		{
+			name:         "sibling is not nested",
+			rules:        []interface{}{rule("example.com", "10.0.0.1"), rule("myexample.com", "10.0.0.2")},
+			addressClass: pdnsCRFRAddressClassAny,
+		},
+		{
+			name:         "no upstream servers",
+			rules:        []interface{}{rule("example.com")},
+			addressClass: pdnsCRFRAddressClassAny,
+			err:          "One of forward_to or views",
+		},
+		{
+			name:         "unreachable address",
+			rules:        []interface{}{rule("example.com", "127.0.0.1")},
+			addressClass: pdnsCRFRAddressClassAny,
+			err:          "is not a reachable IP address",
+		},
+		{
+			name:         "public address in private class",
+			rules:        []interface{}{rule("example.com", "8.8.8.8")},
+			addressClass: pdnsCRFRAddressClassPrivate,
+			err:          "is a public address",
+		},
+		{
+			name:         "private address of a view in public class",
+			rules:        []interface{}{view("example.com", "10.0.0.1")},
+			addressClass: pdnsCRFRAddressClassPublic,
+			err:          "is a private address",
+		},
+		{
+			name:         "unknown address",
+			rules:        []interface{}{rule("example.com", "")},
+			addressClass: pdnsCRFRAddressClassPrivate,
+		},
+	}
+	for _, c := range cases {
+		err := validatePDNSForwardingRules(c.rules, c.addressClass, c.allowNested)
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		case c.err != "" && err == nil:
+			t.Errorf("%s: expected an error containing %q", c.name, c.err)
+		case c.err != "" && !strings.Contains(err.Error(), c.err):
+			t.Errorf("%s: error %q does not contain %q", c.name, err, c.err)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -56,6 +57,13 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage the complete membership of the access group. Members that are not in the configuration are removed on apply, and members that are removed outside of Terraform are added again. Creating the resource fails when the group already has other members.",
+			},
+
 			"members": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -131,6 +139,22 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	// An authoritative resource owns the complete membership. Members that already belong to the
+	// group would be removed without showing up in the plan, so they have to be imported first.
+	if d.Get("authoritative").(bool) {
+		configured := map[string]bool{}
+		for _, id := range append(append(userids, serviceids...), profileids...) {
+			configured[id] = true
+		}
+		unmanaged, err := listUnmanagedAccessGroupMembers(iamAccessGroupsClient, grpID, configured)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(unmanaged) > 0 {
+			return diag.FromErr(fmt.Errorf("[ERROR] Access group %s already has members %s that are not in the configuration. Import the resource, or remove the members, before creating an authoritative ibm_iam_access_group_members", grpID, strings.Join(unmanaged, ", ")))
+		}
+	}
+
 	members := prepareMemberAddRequest(iamAccessGroupsClient, userids, serviceids, profileids)
 
 	addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
@@ -254,6 +278,14 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 	d.Set("members", flex.FlattenAccessGroupMembers(allMembers, res, allrecs))
 	ibmID, serviceID, profileID := flex.FlattenMembersData(allMembers, res, allrecs, allprofiles)
+	// An authoritative resource records empty member types too, so that members of a type that is
+	// not in the configuration are removed on apply.
+	if d.Get("authoritative").(bool) {
+		d.Set("ibm_ids", ibmID)
+		d.Set("iam_service_ids", serviceID)
+		d.Set("iam_profile_ids", profileID)
+		return nil
+	}
 	if len(ibmID) > 0 {
 		d.Set("ibm_ids", ibmID)
 	}
@@ -466,6 +498,38 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 	return nil
 }
 
+// listUnmanagedAccessGroupMembers returns the IAM IDs of the members of an access group that are
+// not in configured. Dynamic members come from access group rules and are not returned.
+func listUnmanagedAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string, configured map[string]bool) ([]string, error) {
+	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
+	offset := int64(0)
+	limit := int64(100)
+	listAccessGroupMembersOptions.SetLimit(limit)
+	var allMembers []iamaccessgroupsv2.ListGroupMembersResponseMember
+	for {
+		listAccessGroupMembersOptions.SetOffset(offset)
+		members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse)
+		}
+		allMembers = append(allMembers, members.Members...)
+		if len(members.Members) == 0 || len(allMembers) >= flex.IntValue(members.TotalCount) {
+			break
+		}
+		offset = offset + limit
+	}
+
+	unmanaged := []string{}
+	for _, member := range allMembers {
+		iamID := flex.StringValue(member.IamID)
+		if iamID == "" || configured[iamID] || flex.StringValue(member.MembershipType) == "dynamic" {
+			continue
+		}
+		unmanaged = append(unmanaged, iamID)
+	}
+	return unmanaged, nil
+}
+
 func prepareMemberAddRequest(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, userIds, serviceIds, profileIds []string) (members []iamaccessgroupsv2.AddGroupMembersRequestMembersItem) {
 	members = make([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem, len(userIds)+len(serviceIds)+len(profileIds))
 	var i = 0
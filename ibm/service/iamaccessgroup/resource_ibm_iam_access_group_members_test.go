@@ -5,6 +5,7 @@ package iamaccessgroup_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -76,6 +77,101 @@ func TestAccIBMIAMAccessGroupMember_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccessGroupMember_Authoritative(t *testing.T) {
+	var accessGroupID, serviceIamID string
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberAuthoritativeGroup(name, sname),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMAccessGroupMemberAttr("ibm_iam_access_group.accgroup", "id", &accessGroupID),
+					testAccCheckIBMIAMAccessGroupMemberAttr("ibm_iam_service_id.serviceID", "iam_id", &serviceIamID),
+				),
+			},
+			{
+				// A member that belongs to the group before the resource is created is not removed
+				// without showing up in the plan, so creating the resource fails.
+				PreConfig:   func() { testAccAddAccessGroupMemberOutOfBand(t, accessGroupID, serviceIamID, "service") },
+				Config:      testAccCheckIBMIAMAccessGroupMemberAuthoritative(name, sname),
+				ExpectError: regexp.MustCompile("already has members"),
+			},
+			{
+				PreConfig: func() { testAccRemoveAccessGroupMemberOutOfBand(t, accessGroupID, serviceIamID) },
+				Config:    testAccCheckIBMIAMAccessGroupMemberAuthoritative(name, sname),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "authoritative", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "0"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+				),
+			},
+			{
+				// The only user of the group is removed outside of Terraform and added again.
+				PreConfig: func() { testAccRemoveAccessGroupUserOutOfBand(t, accessGroupID, acc.IAMUser) },
+				Config:    testAccCheckIBMIAMAccessGroupMemberAuthoritative(name, sname),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "ibm_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupMemberAttr(n, attr string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*value = rs.Primary.Attributes[attr]
+		return nil
+	}
+}
+
+func testAccAddAccessGroupMemberOutOfBand(t *testing.T, accessGroupID, iamID, memberType string) {
+	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := accClient.NewAddGroupMembersRequestMembersItem(iamID, memberType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addMembersToAccessGroupOptions := accClient.NewAddMembersToAccessGroupOptions(accessGroupID)
+	addMembersToAccessGroupOptions.SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{*member})
+	if _, response, err := accClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions); err != nil {
+		t.Fatalf("Error adding member %s to access group %s: %s\n%s", iamID, accessGroupID, err, response)
+	}
+}
+
+func testAccRemoveAccessGroupUserOutOfBand(t *testing.T, accessGroupID, user string) {
+	userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iamID, err := flex.GetIBMUniqueId(userDetails.UserAccount, user, acc.TestAccProvider.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testAccRemoveAccessGroupMemberOutOfBand(t, accessGroupID, iamID)
+}
+
+func testAccRemoveAccessGroupMemberOutOfBand(t *testing.T, accessGroupID, iamID string) {
+	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response, err := accClient.RemoveMemberFromAccessGroup(accClient.NewRemoveMemberFromAccessGroupOptions(accessGroupID, iamID)); err != nil {
+		t.Fatalf("Error removing member %s from access group %s: %s\n%s", iamID, accessGroupID, err, response)
+	}
+}
+
 func TestAccIBMIAMAccessGroupMember_import(t *testing.T) {
 	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
@@ -169,6 +265,36 @@ func testAccCheckIBMIAMAccessGroupMemberAddServiceID(name, sname string) string
 	}`, name, sname, acc.IAMUser)
 }
 
+func testAccCheckIBMIAMAccessGroupMemberAuthoritativeGroup(name, sname string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+  		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+  		name = "%s"
+	}`, name, sname)
+}
+
+func testAccCheckIBMIAMAccessGroupMemberAuthoritative(name, sname string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+  		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+  		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_members" "accgroupmem" {
+  		access_group_id = ibm_iam_access_group.accgroup.id
+  		ibm_ids         = ["%s"]
+  		authoritative   = true
+	}`, name, sname, acc.IAMUser)
+}
+
 func testAccCheckIBMIAMAccessGroupMemberAddAnotherServiceID(name, sname, sname1 string) string {
 	return fmt.Sprintf(`
 
@@ -0,0 +1,502 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceIBMIAMServiceAPIKeyRotation keeps an active and a previous API key for a service ID.
+// A new active key is created when the rotation is due or the triggers change, and the previous
+// key is deleted once its grace period has passed.
+func ResourceIBMIAMServiceAPIKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMServiceAPIKeyRotationCreate,
+		ReadContext:   resourceIBMIAMServiceAPIKeyRotationRead,
+		UpdateContext: resourceIBMIAMServiceAPIKeyRotationUpdate,
+		DeleteContext: resourceIBMIAMServiceAPIKeyRotationDelete,
+		CustomizeDiff: resourceIBMIAMServiceAPIKeyRotationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"iam_service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The service iam_id that the API keys authenticate",
+				ValidateFunc: validate.InvokeValidator("ibm_iam_service_api_key_rotation",
+					"iam_service_id"),
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the API keys",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the API keys",
+			},
+
+			"rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of days after which a new API key is created",
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, creates a new API key",
+			},
+
+			"grace_period_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days that the previous API key remains valid after a rotation",
+			},
+
+			"secrets_manager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Arbitrary secret of a Secrets Manager instance that receives every new API key as a new secret version",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the Secrets Manager instance",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The region of the Secrets Manager instance",
+						},
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+							Description:  "public or private",
+						},
+						"secret_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the arbitrary secret",
+						},
+					},
+				},
+			},
+
+			"apikey_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the active API key",
+			},
+
+			"apikey": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the active API key",
+			},
+
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the active API key was created",
+			},
+
+			"next_rotation_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time from which the next plan creates a new API key",
+			},
+
+			"previous_apikey_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the previous API key",
+			},
+
+			"previous_apikey": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the previous API key",
+			},
+
+			"previous_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time from which the next apply deletes the previous API key",
+			},
+
+			"secret_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Secrets Manager secret version that holds the active API key",
+			},
+		},
+	}
+}
+
+func ResourceIBMIAMServiceAPIKeyRotationValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "iam_service_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "iam",
+			CloudDataRange:             []string{"service:service_id", "resolved_to:id"},
+			Required:                   true})
+
+	iBMIAMServiceAPIKeyRotationValidator := validate.ResourceValidator{ResourceName: "ibm_iam_service_api_key_rotation", Schema: validateSchema}
+	return &iBMIAMServiceAPIKeyRotationValidator
+}
+
+// resourceIBMIAMServiceAPIKeyRotationCustomizeDiff plans a rotation when it is due or the
+// triggers change, and plans the deletion of a previous API key whose grace period has passed.
+func resourceIBMIAMServiceAPIKeyRotationCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	// A new key is also created when the active key was deleted outside of Terraform
+	rotationDue := diff.Get("apikey_id").(string) == "" ||
+		apiKeyRotationDue(nextAPIKeyRotation(diff.Get("rotated_at").(string), diff.Get("rotation_days").(int)))
+	if diff.HasChange("triggers") || rotationDue {
+		for _, key := range []string{"apikey_id", "apikey", "rotated_at", "next_rotation_at", "previous_apikey_id", "previous_apikey", "previous_expires_at"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		if _, ok := diff.GetOk("secrets_manager"); ok {
+			return diff.SetNewComputed("secret_version_id")
+		}
+		return nil
+	}
+	if diff.Get("previous_apikey_id").(string) != "" && apiKeyRotationDue(diff.Get("previous_expires_at").(string)) {
+		for _, key := range []string{"previous_apikey_id", "previous_apikey", "previous_expires_at"} {
+			if err := diff.SetNew(key, ""); err != nil {
+				return err
+			}
+		}
+	}
+	if diff.HasChange("rotation_days") {
+		if err := diff.SetNewComputed("next_rotation_at"); err != nil {
+			return err
+		}
+	}
+	if diff.HasChange("secrets_manager") {
+		return diff.SetNewComputed("secret_version_id")
+	}
+	return nil
+}
+
+func resourceIBMIAMServiceAPIKeyRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiKey, err := createRotatedAPIKey(context, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("iam_service_id").(string), *apiKey.ID))
+	setRotatedAPIKey(d, apiKey)
+
+	if err := storeRotatedAPIKey(context, d, meta, *apiKey.Apikey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIAMServiceAPIKeyRotationRead(context, d, meta)
+}
+
+func resourceIBMIAMServiceAPIKeyRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiKeyID := d.Get("apikey_id").(string)
+	if apiKeyID == "" {
+		return nil
+	}
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(context, &iamidentityv1.GetAPIKeyOptions{
+		ID: &apiKeyID,
+	})
+	if err != nil || apiKey == nil {
+		if response != nil && response.StatusCode == 404 {
+			// Keep the previous key in the state, the next apply rotates to a new key and
+			// deletes the previous key like any other rotation
+			log.Printf("[WARN] Active API key %s of %s was deleted, a new API key will be created", apiKeyID, d.Id())
+			d.Set("apikey_id", "")
+			d.Set("apikey", "")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Service API Key: %s\n%s", err, response))
+	}
+	if apiKey.IamID != nil {
+		d.Set("iam_service_id", *apiKey.IamID)
+	}
+
+	if previousID := d.Get("previous_apikey_id").(string); previousID != "" {
+		_, response, err := iamIdentityClient.GetAPIKeyWithContext(context, &iamidentityv1.GetAPIKeyOptions{
+			ID: &previousID,
+		})
+		if err != nil {
+			if response == nil || response.StatusCode != 404 {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Service API Key: %s\n%s", err, response))
+			}
+			d.Set("previous_apikey_id", "")
+			d.Set("previous_apikey", "")
+			d.Set("previous_expires_at", "")
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIAMServiceAPIKeyRotationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	previousID, _ := d.GetChange("previous_apikey_id")
+	rotatedAt, _ := d.GetChange("rotated_at")
+
+	// Rotations and deletions are decided in CustomizeDiff, apply what was planned even if
+	// a rotation has become due since the plan
+	if apiKeyRotationPlanned(d) {
+		// Only one previous API key is kept. A key that is still in its grace period when the
+		// next rotation happens is deleted.
+		if previousID.(string) != "" {
+			if err := deleteRotatedAPIKey(context, iamIdentityClient, previousID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		activeID, _ := d.GetChange("apikey_id")
+		activeKey, _ := d.GetChange("apikey")
+
+		apiKey, err := createRotatedAPIKey(context, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setRotatedAPIKey(d, apiKey)
+		log.Printf("[INFO] Rotated API key %s of %s to %s", activeID, d.Id(), *apiKey.ID)
+
+		gracePeriod := time.Duration(d.Get("grace_period_days").(int)) * 24 * time.Hour
+		if activeID.(string) == "" {
+			// The active key was deleted outside of Terraform, there is no key to keep
+			d.Set("previous_apikey_id", "")
+			d.Set("previous_apikey", "")
+			d.Set("previous_expires_at", "")
+		} else if gracePeriod == 0 {
+			if err := deleteRotatedAPIKey(context, iamIdentityClient, activeID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("previous_apikey_id", "")
+			d.Set("previous_apikey", "")
+			d.Set("previous_expires_at", "")
+		} else {
+			d.Set("previous_apikey_id", activeID.(string))
+			d.Set("previous_apikey", activeKey.(string))
+			d.Set("previous_expires_at", time.Now().UTC().Add(gracePeriod).Format(time.RFC3339))
+		}
+
+		if err := storeRotatedAPIKey(context, d, meta, *apiKey.Apikey); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if previousID.(string) != "" && d.Get("previous_apikey_id").(string) == "" {
+			if err := deleteRotatedAPIKey(context, iamIdentityClient, previousID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("previous_apikey_id", "")
+			d.Set("previous_apikey", "")
+			d.Set("previous_expires_at", "")
+		}
+
+		if d.HasChange("name") || d.HasChange("description") {
+			apiKeyID := d.Get("apikey_id").(string)
+			apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(context, &iamidentityv1.GetAPIKeyOptions{
+				ID: &apiKeyID,
+			})
+			if err != nil || apiKey == nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving Service API Key: %s\n%s", err, response))
+			}
+			updateAPIKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{
+				ID:          &apiKeyID,
+				IfMatch:     apiKey.EntityTag,
+				Name:        core.StringPtr(d.Get("name").(string)),
+				Description: core.StringPtr(d.Get("description").(string)),
+			}
+			_, response, err = iamIdentityClient.UpdateAPIKeyWithContext(context, updateAPIKeyOptions)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating Service API Key: %s\n%s", err, response))
+			}
+		}
+
+		if d.HasChange("rotation_days") {
+			d.Set("next_rotation_at", nextAPIKeyRotation(rotatedAt.(string), d.Get("rotation_days").(int)))
+		}
+
+		if d.HasChange("secrets_manager") {
+			if err := storeRotatedAPIKey(context, d, meta, d.Get("apikey").(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMIAMServiceAPIKeyRotationRead(context, d, meta)
+}
+
+func resourceIBMIAMServiceAPIKeyRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, key := range []string{"apikey_id", "previous_apikey_id"} {
+		if apiKeyID := d.Get(key).(string); apiKeyID != "" {
+			if err := deleteRotatedAPIKey(context, iamIdentityClient, apiKeyID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func createRotatedAPIKey(context context.Context, d *schema.ResourceData, meta interface{}) (*iamidentityv1.APIKey, error) {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	createAPIKeyOptions := &iamidentityv1.CreateAPIKeyOptions{
+		Name:       core.StringPtr(d.Get("name").(string)),
+		IamID:      core.StringPtr(d.Get("iam_service_id").(string)),
+		AccountID:  &userDetails.UserAccount,
+		StoreValue: core.BoolPtr(false),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		createAPIKeyOptions.Description = core.StringPtr(description.(string))
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(context, createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return nil, fmt.Errorf("[ERROR] Service API Key creation Error: %s\n%s", err, response)
+	}
+	return apiKey, nil
+}
+
+func setRotatedAPIKey(d *schema.ResourceData, apiKey *iamidentityv1.APIKey) {
+	rotatedAt := time.Now().UTC().Format(time.RFC3339)
+	d.Set("apikey_id", *apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+	d.Set("rotated_at", rotatedAt)
+	d.Set("next_rotation_at", nextAPIKeyRotation(rotatedAt, d.Get("rotation_days").(int)))
+}
+
+func deleteRotatedAPIKey(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, apiKeyID string) error {
+	response, err := iamIdentityClient.DeleteAPIKeyWithContext(context, &iamidentityv1.DeleteAPIKeyOptions{
+		ID: &apiKeyID,
+	})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting Service API Key %s: %s\n%s", apiKeyID, err, response)
+	}
+	return nil
+}
+
+// storeRotatedAPIKey adds the API key as a new version of the configured arbitrary secret.
+func storeRotatedAPIKey(context context.Context, d *schema.ResourceData, meta interface{}, apiKey string) error {
+	if _, ok := d.GetOk("secrets_manager"); !ok {
+		d.Set("secret_version_id", "")
+		return nil
+	}
+	instanceID := d.Get("secrets_manager.0.instance_id").(string)
+	secretID := d.Get("secrets_manager.0.secret_id").(string)
+	secretsManagerClient, err := secretsmanager.GetInstanceClient(meta.(conns.ClientSession), instanceID,
+		d.Get("secrets_manager.0.region").(string), d.Get("secrets_manager.0.endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretID)
+	createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.ArbitrarySecretVersionPrototype{
+		Payload: &apiKey,
+		VersionCustomMetadata: map[string]interface{}{
+			"apikey_id": d.Get("apikey_id").(string),
+		},
+	})
+	version, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error storing API key %s in secret %s of Secrets Manager instance %s: %s\n%s", d.Get("apikey_id"), secretID, instanceID, err, response)
+	}
+	if arbitraryVersion, ok := version.(*secretsmanagerv2.ArbitrarySecretVersion); ok && arbitraryVersion.ID != nil {
+		d.Set("secret_version_id", *arbitraryVersion.ID)
+	}
+	return nil
+}
+
+// apiKeyRotationPlanned reports whether the plan creates a new active API key, which
+// CustomizeDiff marks by leaving rotated_at unknown.
+func apiKeyRotationPlanned(d *schema.ResourceData) bool {
+	plan := d.GetRawPlan()
+	if plan.IsNull() || !plan.IsKnown() {
+		return false
+	}
+	return !plan.GetAttr("rotated_at").IsKnown()
+}
+
+// nextAPIKeyRotation returns the time from which a key created at rotatedAt is rotated, or an
+// empty string when keys are only rotated through the triggers.
+func nextAPIKeyRotation(rotatedAt string, rotationDays int) string {
+	if rotatedAt == "" || rotationDays == 0 {
+		return ""
+	}
+	at, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return ""
+	}
+	return at.Add(time.Duration(rotationDays) * 24 * time.Hour).Format(time.RFC3339)
+}
+
+// apiKeyRotationDue reports whether a timestamp written by this resource has passed.
+func apiKeyRotationDue(at string) bool {
+	if at == "" {
+		return false
+	}
+	due, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return false
+	}
+	return !time.Now().Before(due)
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMServiceAPIKeyRotation_Basic(t *testing.T) {
+	var firstKeyID string
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotationBasic(serviceName, name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key_rotation.rotation", "apikey_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key_rotation.rotation", "apikey"),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key_rotation.rotation", "next_rotation_at"),
+					resource.TestCheckResourceAttr("ibm_iam_service_api_key_rotation.rotation", "previous_apikey_id", ""),
+					testAccCheckIBMIAMServiceAPIKeyRotationKeyID("ibm_iam_service_api_key_rotation.rotation", &firstKeyID),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotationBasic(serviceName, name, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key_rotation.rotation", "apikey_id"),
+					resource.TestCheckResourceAttrPtr("ibm_iam_service_api_key_rotation.rotation", "previous_apikey_id", &firstKeyID),
+					resource.TestCheckResourceAttrSet("ibm_iam_service_api_key_rotation.rotation", "previous_expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMServiceAPIKeyRotationKeyID(n string, apiKeyID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*apiKeyID = rs.Primary.Attributes["apikey_id"]
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMServiceAPIKeyRotationDestroy(s *terraform.State) error {
+	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_service_api_key_rotation" {
+			continue
+		}
+
+		for _, key := range []string{"apikey_id", "previous_apikey_id"} {
+			apiKeyID := rs.Primary.Attributes[key]
+			if apiKeyID == "" {
+				continue
+			}
+			getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
+				ID: &apiKeyID,
+			}
+			_, _, err := rsContClient.GetAPIKey(getAPIKeyOptions)
+			if err == nil {
+				return fmt.Errorf("Service API Key Still Exists: %s", apiKeyID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMServiceAPIKeyRotationBasic(serviceName, name, trigger string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_service_api_key_rotation" "rotation" {
+			name              = "%s"
+			iam_service_id    = ibm_iam_service_id.serviceID.iam_id
+			rotation_days     = 30
+			grace_period_days = 2
+			triggers = {
+				rotation = "%s"
+			}
+		}
+	`, serviceName, name, trigger)
+}
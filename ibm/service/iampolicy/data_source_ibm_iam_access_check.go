@@ -0,0 +1,434 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	accessCheckAllowed     = "allowed"
+	accessCheckDenied      = "denied"
+	accessCheckConditional = "conditional"
+)
+
+// Data source to evaluate whether a subject is granted an action on a resource by the access policies of the account
+func DataSourceIBMIAMAccessCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMAccessCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"iam_id", "service_id", "profile_id", "access_group_id"},
+				Description:  "IAM ID of the user, service ID or trusted profile to check.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the service ID to check.",
+			},
+			"profile_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the trusted profile to check.",
+			},
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the access group to check.",
+			},
+			"include_access_groups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Evaluate the policies of the access groups the subject is a member of.",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The action to check, for example cloud-object-storage.object.get.",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Service name of the resource. Defaults to the service of resource_crn.",
+			},
+			"resource_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CRN of the resource to check.",
+			},
+			"resource_attributes": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional resource attributes, for example resourceGroupId, resourceType or resource. They take precedence over the attributes of resource_crn.",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a policy without conditions grants the action on the resource.",
+			},
+			"decision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The decision: allowed, conditional when only policies with rule conditions or access tags grant the action, or denied.",
+			},
+			"matching_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The policies that grant the action on the resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the policy.",
+						},
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the access group the policy is assigned to, if the policy is inherited from an access group.",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Display names of the roles of the policy that include the action.",
+						},
+						"conditional": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the policy only grants the action under rule conditions or access tags.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// accessCheckPolicy is a policy of the subject together with the access group it is inherited from.
+type accessCheckPolicy struct {
+	policy        iampolicymanagementv1.V2PolicyTemplateMetaData
+	accessGroupID string
+}
+
+func dataSourceIBMIAMAccessCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	accountID := userDetails.UserAccount
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subject, iamID, err := accessCheckSubject(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	target, err := accessCheckTarget(ctx, d, meta, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serviceName := target["serviceName"]
+	action := d.Get("action").(string)
+
+	// Collect the policies of the subject and of the access groups it is a member of
+	var policies []accessCheckPolicy
+	accessGroupIDs := []string{}
+	if v, ok := d.GetOk("access_group_id"); ok {
+		accessGroupIDs = append(accessGroupIDs, v.(string))
+	} else {
+		subjectPolicies, err := listAccessCheckPolicies(ctx, iamPolicyManagementClient, &iampolicymanagementv1.ListV2PoliciesOptions{
+			AccountID: core.StringPtr(accountID),
+			IamID:     core.StringPtr(iamID),
+			Type:      core.StringPtr("access"),
+			State:     core.StringPtr("active"),
+		})
+		if err != nil {
+			return diag.Errorf("[ERROR] Error listing policies of %s: %s", subject, err)
+		}
+		for _, policy := range subjectPolicies {
+			policies = append(policies, accessCheckPolicy{policy: policy})
+		}
+		if d.Get("include_access_groups").(bool) {
+			accessGroupIDs, err = listAccessCheckAccessGroups(ctx, meta, accountID, iamID)
+			if err != nil {
+				return diag.Errorf("[ERROR] Error listing access groups of %s: %s", subject, err)
+			}
+		}
+	}
+	for _, accessGroupID := range accessGroupIDs {
+		groupPolicies, err := listAccessCheckPolicies(ctx, iamPolicyManagementClient, &iampolicymanagementv1.ListV2PoliciesOptions{
+			AccountID:     core.StringPtr(accountID),
+			AccessGroupID: core.StringPtr(accessGroupID),
+			Type:          core.StringPtr("access"),
+			State:         core.StringPtr("active"),
+		})
+		if err != nil {
+			return diag.Errorf("[ERROR] Error listing policies of access group %s: %s", accessGroupID, err)
+		}
+		for _, policy := range groupPolicies {
+			policies = append(policies, accessCheckPolicy{policy: policy, accessGroupID: accessGroupID})
+		}
+	}
+
+	// Resolve the actions of the roles that can be assigned for the service
+	roleList, _, err := iamPolicyManagementClient.ListRolesWithContext(ctx, &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   core.StringPtr(accountID),
+		ServiceName: core.StringPtr(serviceName),
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] Error listing roles of service %s: %s", serviceName, err)
+	}
+	roleActions := map[string][]string{}
+	roleNames := map[string]string{}
+	for _, role := range append(roleList.SystemRoles, roleList.ServiceRoles...) {
+		roleActions[*role.CRN] = role.Actions
+		roleNames[*role.CRN] = *role.DisplayName
+	}
+	for _, role := range roleList.CustomRoles {
+		roleActions[*role.CRN] = role.Actions
+		roleNames[*role.CRN] = *role.DisplayName
+	}
+
+	decision := accessCheckDenied
+	matchingPolicies := []map[string]interface{}{}
+	for _, p := range policies {
+		if p.policy.Resource == nil || !accessCheckResourceMatches(p.policy.Resource.Attributes, target) {
+			continue
+		}
+		control, ok := p.policy.Control.(*iampolicymanagementv1.ControlResponse)
+		if !ok || control.Grant == nil {
+			continue
+		}
+		grantingRoles := []string{}
+		for _, role := range control.Grant.Roles {
+			for _, a := range roleActions[*role.RoleID] {
+				if a == action {
+					grantingRoles = append(grantingRoles, roleNames[*role.RoleID])
+					break
+				}
+			}
+		}
+		if len(grantingRoles) == 0 {
+			continue
+		}
+		conditional := p.policy.Rule != nil || len(p.policy.Resource.Tags) > 0
+		if !conditional {
+			decision = accessCheckAllowed
+		} else if decision == accessCheckDenied {
+			decision = accessCheckConditional
+		}
+		matchingPolicy := map[string]interface{}{
+			"id":              *p.policy.ID,
+			"access_group_id": p.accessGroupID,
+			"roles":           grantingRoles,
+			"conditional":     conditional,
+		}
+		if p.policy.Description != nil {
+			matchingPolicy["description"] = *p.policy.Description
+		}
+		matchingPolicies = append(matchingPolicies, matchingPolicy)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", subject, action, d.Get("resource_crn").(string)))
+	d.Set("service", serviceName)
+	d.Set("allowed", decision == accessCheckAllowed)
+	d.Set("decision", decision)
+	if err := d.Set("matching_policies", matchingPolicies); err != nil {
+		return diag.Errorf("[ERROR] Error setting matching_policies: %s", err)
+	}
+	return nil
+}
+
+// accessCheckSubject returns a description of the subject and its IAM ID
+func accessCheckSubject(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, string, error) {
+	if v, ok := d.GetOk("access_group_id"); ok {
+		return v.(string), "", nil
+	}
+	if v, ok := d.GetOk("iam_id"); ok {
+		return v.(string), v.(string), nil
+	}
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return "", "", err
+	}
+	if v, ok := d.GetOk("service_id"); ok {
+		serviceID, response, err := iamIdentityClient.GetServiceIDWithContext(ctx, &iamidentityv1.GetServiceIDOptions{
+			ID: core.StringPtr(v.(string)),
+		})
+		if err != nil {
+			return "", "", fmt.Errorf("[ERROR] Error getting service ID %s: %s\n%s", v, err, response)
+		}
+		return v.(string), *serviceID.IamID, nil
+	}
+	profileID := d.Get("profile_id").(string)
+	profile, response, err := iamIdentityClient.GetProfileWithContext(ctx, &iamidentityv1.GetProfileOptions{
+		ProfileID: core.StringPtr(profileID),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("[ERROR] Error getting trusted profile %s: %s\n%s", profileID, err, response)
+	}
+	return profileID, *profile.IamID, nil
+}
+
+// accessCheckTarget builds the attributes of the resource from its CRN, the service and the
+// additional resource attributes.
+func accessCheckTarget(ctx context.Context, d *schema.ResourceData, meta interface{}, accountID string) (map[string]string, error) {
+	target := map[string]string{
+		"accountId": accountID,
+	}
+	if v, ok := d.GetOk("resource_crn"); ok {
+		// crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+		crn := strings.SplitN(v.(string), ":", 10)
+		if len(crn) != 10 || crn[0] != "crn" {
+			return nil, fmt.Errorf("[ERROR] %s is not a valid CRN", v)
+		}
+		keys := map[int]string{4: "serviceName", 5: "region", 7: "serviceInstance", 8: "resourceType", 9: "resource"}
+		for i, key := range keys {
+			if crn[i] != "" {
+				target[key] = crn[i]
+			}
+		}
+		if strings.HasPrefix(crn[6], "a/") {
+			target["accountId"] = strings.TrimPrefix(crn[6], "a/")
+		}
+		// Policies scoped to a resource group need the resource group of the instance
+		if _, ok := d.Get("resource_attributes").(map[string]interface{})["resourceGroupId"]; !ok && crn[7] != "" {
+			instanceCRN := strings.Join(append(crn[:8:8], "", ""), ":")
+			if resourceGroupID := accessCheckResourceGroup(ctx, meta, instanceCRN); resourceGroupID != "" {
+				target["resourceGroupId"] = resourceGroupID
+			}
+		}
+	}
+	if v, ok := d.GetOk("service"); ok {
+		target["serviceName"] = v.(string)
+	}
+	for key, value := range d.Get("resource_attributes").(map[string]interface{}) {
+		target[key] = value.(string)
+	}
+	if target["serviceName"] == "" {
+		return nil, fmt.Errorf("[ERROR] one of service, resource_crn or resource_attributes.serviceName must be set")
+	}
+	if _, ok := target["serviceType"]; !ok {
+		target["serviceType"] = "service"
+	}
+	return target, nil
+}
+
+func accessCheckResourceGroup(ctx context.Context, meta interface{}, instanceCRN string) string {
+	resourceControllerClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return ""
+	}
+	instance, _, err := resourceControllerClient.GetResourceInstanceWithContext(ctx, &resourcecontrollerv2.GetResourceInstanceOptions{
+		ID: core.StringPtr(instanceCRN),
+	})
+	if err != nil || instance.ResourceGroupID == nil {
+		log.Printf("[DEBUG] Unable to look up the resource group of %s: %s", instanceCRN, err)
+		return ""
+	}
+	return *instance.ResourceGroupID
+}
+
+// accessCheckResourceMatches reports whether every resource attribute of a policy matches the target
+func accessCheckResourceMatches(attributes []iampolicymanagementv1.V2PolicyResourceAttribute, target map[string]string) bool {
+	for _, attribute := range attributes {
+		targetValue, exists := target[*attribute.Key]
+		switch *attribute.Operator {
+		case "stringExists":
+			if want, ok := attribute.Value.(bool); ok && want != exists {
+				return false
+			}
+		case "stringMatch":
+			if !exists || !accessCheckWildcardMatches(fmt.Sprint(attribute.Value), targetValue) {
+				return false
+			}
+		default:
+			if !exists || fmt.Sprint(attribute.Value) != targetValue {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// accessCheckWildcardMatches matches a value against a pattern where * matches any sequence of
+// characters and ? matches a single character.
+func accessCheckWildcardMatches(pattern, value string) bool {
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.ReplaceAll(expression, `\*`, ".*")
+	expression = strings.ReplaceAll(expression, `\?`, ".")
+	matched, _ := regexp.MatchString("^"+expression+"$", value)
+	return matched
+}
+
+func listAccessCheckPolicies(ctx context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, options *iampolicymanagementv1.ListV2PoliciesOptions) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, error) {
+	var policies []iampolicymanagementv1.V2PolicyTemplateMetaData
+	for {
+		policyList, response, err := client.ListV2PoliciesWithContext(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("%s\n%s", err, response)
+		}
+		policies = append(policies, policyList.Policies...)
+		if policyList.Next == nil || policyList.Next.Start == nil {
+			return policies, nil
+		}
+		options.Start = policyList.Next.Start
+	}
+}
+
+func listAccessCheckAccessGroups(ctx context.Context, meta interface{}, accountID, iamID string) ([]string, error) {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+	accessGroupIDs := []string{}
+	options := &iamaccessgroupsv2.ListAccessGroupsOptions{
+		AccountID: core.StringPtr(accountID),
+		IamID:     core.StringPtr(iamID),
+		Limit:     core.Int64Ptr(100),
+		Offset:    core.Int64Ptr(0),
+	}
+	for {
+		groupsList, response, err := iamAccessGroupsClient.ListAccessGroupsWithContext(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("%s\n%s", err, response)
+		}
+		for _, group := range groupsList.Groups {
+			accessGroupIDs = append(accessGroupIDs, *group.ID)
+		}
+		if groupsList.Next == nil || len(groupsList.Groups) == 0 {
+			return accessGroupIDs, nil
+		}
+		options.Offset = core.Int64Ptr(*options.Offset + int64(len(groupsList.Groups)))
+	}
+}
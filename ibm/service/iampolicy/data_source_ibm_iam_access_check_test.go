@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccessCheckDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessCheckDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "decision", "allowed"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.read", "matching_policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_access_check.read", "matching_policies.0.access_group_id", "ibm_iam_access_group.group", "id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.write", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.write", "decision", "denied"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.write", "matching_policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessCheckDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ibm_iam_service_id" "service_id" {
+  name = "%[1]s"
+}
+
+resource "ibm_iam_access_group" "group" {
+  name = "%[1]s"
+}
+
+resource "ibm_iam_access_group_members" "members" {
+  access_group_id = ibm_iam_access_group.group.id
+  iam_service_ids = [ibm_iam_service_id.service_id.id]
+}
+
+resource "ibm_iam_access_group_policy" "policy" {
+  access_group_id = ibm_iam_access_group.group.id
+  roles           = ["Reader"]
+  resources {
+    service = "kms"
+  }
+}
+
+data "ibm_iam_access_check" "read" {
+  service_id = ibm_iam_service_id.service_id.id
+  service    = "kms"
+  action     = "kms.secrets.list"
+  depends_on = [ibm_iam_access_group_members.members, ibm_iam_access_group_policy.policy]
+}
+
+data "ibm_iam_access_check" "write" {
+  service_id = ibm_iam_service_id.service_id.id
+  service    = "kms"
+  action     = "kms.secrets.delete"
+  depends_on = [ibm_iam_access_group_members.members, ibm_iam_access_group_policy.policy]
+}
+`, name)
+}
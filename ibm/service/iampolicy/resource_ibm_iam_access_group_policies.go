@@ -0,0 +1,490 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMAccessGroupPolicies manages the complete set of access policies of an access
+// group. Policies of the group that are not in the configuration are read into the state, so
+// they show up in the plan as removals and are deleted on apply. Creating the resource fails
+// when the group has policies that are not in the configuration.
+func ResourceIBMIAMAccessGroupPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccessGroupPoliciesCreate,
+		ReadContext:   resourceIBMIAMAccessGroupPoliciesRead,
+		UpdateContext: resourceIBMIAMAccessGroupPoliciesUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupPoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the access group that owns the policies.",
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_policies",
+					"access_group_id"),
+			},
+			"policy": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The complete set of access policies of the access group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"roles": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Role names granted by the policy.",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Resource attributes of the policy. The account ID attribute is added automatically.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "stringEquals",
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMIAMAccessGroupPoliciesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "access_group_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "iam",
+			CloudDataRange:             []string{"service:access_group", "resolved_to:id"},
+			Required:                   true})
+
+	iBMIAMAccessGroupPoliciesValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_group_policies", Schema: validateSchema}
+	return &iBMIAMAccessGroupPoliciesValidator
+}
+
+func resourceIBMIAMAccessGroupPoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accessGroupID := d.Get("access_group_id").(string)
+
+	manager, err := newAccessGroupPolicyManager(context, meta, accessGroupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	existing, err := manager.list()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Policies that already exist are adopted instead of being created a second time. The other
+	// policies of the group would be deleted without showing up in the plan, so they have to be
+	// imported first.
+	configured := map[string]bool{}
+	for _, p := range d.Get("policy").(*schema.Set).List() {
+		configured[accessGroupPolicyKey(p.(map[string]interface{}))] = true
+	}
+	var unmanaged []string
+	for key, policyIDs := range existing {
+		if !configured[key] {
+			unmanaged = append(unmanaged, policyIDs...)
+		}
+	}
+	if len(unmanaged) > 0 {
+		sort.Strings(unmanaged)
+		return diag.FromErr(fmt.Errorf("[ERROR] Access group %s already has policies %s that are not in the configuration. Import the resource, or delete the policies, before creating ibm_iam_access_group_policies", accessGroupID, strings.Join(unmanaged, ", ")))
+	}
+	for _, p := range d.Get("policy").(*schema.Set).List() {
+		policy := p.(map[string]interface{})
+		if len(existing[accessGroupPolicyKey(policy)]) > 0 {
+			continue
+		}
+		if err := manager.create(policy); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(accessGroupID)
+
+	return resourceIBMIAMAccessGroupPoliciesRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	manager, err := newAccessGroupPolicyManager(context, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policies, err := manager.listPolicies()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		flattened, err := manager.flatten(policy)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		result = append(result, flattened)
+	}
+
+	d.Set("access_group_id", d.Id())
+	if err := d.Set("policy", result); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting policy: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMIAMAccessGroupPoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("policy") {
+		manager, err := newAccessGroupPolicyManager(context, meta, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		existing, err := manager.list()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		o, n := d.GetChange("policy")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, p := range os.Difference(ns).List() {
+			for _, policyID := range existing[accessGroupPolicyKey(p.(map[string]interface{}))] {
+				if err := manager.delete(policyID); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		for _, p := range ns.Difference(os).List() {
+			policy := p.(map[string]interface{})
+			if len(existing[accessGroupPolicyKey(policy)]) > 0 {
+				continue
+			}
+			if err := manager.create(policy); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMIAMAccessGroupPoliciesRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	manager, err := newAccessGroupPolicyManager(context, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	existing, err := manager.list()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, p := range d.Get("policy").(*schema.Set).List() {
+		for _, policyID := range existing[accessGroupPolicyKey(p.(map[string]interface{}))] {
+			if err := manager.delete(policyID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// accessGroupPolicyManager lists, creates and deletes the access policies of one access group
+// and translates role names to role CRNs for them.
+type accessGroupPolicyManager struct {
+	ctx           context.Context
+	client        *iampolicymanagementv1.IamPolicyManagementV1
+	accountID     string
+	accessGroupID string
+	roles         map[string][]iampolicymanagementv1.PolicyRole
+}
+
+func newAccessGroupPolicyManager(ctx context.Context, meta interface{}, accessGroupID string) (*accessGroupPolicyManager, error) {
+	client, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	return &accessGroupPolicyManager{
+		ctx:           ctx,
+		client:        client,
+		accountID:     userDetails.UserAccount,
+		accessGroupID: accessGroupID,
+		roles:         map[string][]iampolicymanagementv1.PolicyRole{},
+	}, nil
+}
+
+func (m *accessGroupPolicyManager) listPolicies() ([]iampolicymanagementv1.V2PolicyTemplateMetaData, error) {
+	policies, err := listAccessCheckPolicies(m.ctx, m.client, &iampolicymanagementv1.ListV2PoliciesOptions{
+		AccountID:     &m.accountID,
+		AccessGroupID: &m.accessGroupID,
+		Type:          core.StringPtr("access"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the policies of access group %s: %s", m.accessGroupID, err)
+	}
+	return policies, nil
+}
+
+// list returns the IDs of the policies of the access group by policy key.
+func (m *accessGroupPolicyManager) list() (map[string][]string, error) {
+	policies, err := m.listPolicies()
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string][]string{}
+	for _, policy := range policies {
+		flattened, err := m.flatten(policy)
+		if err != nil {
+			return nil, err
+		}
+		if policy.ID == nil {
+			continue
+		}
+		key := accessGroupPolicyKey(flattened)
+		existing[key] = append(existing[key], *policy.ID)
+	}
+	return existing, nil
+}
+
+func (m *accessGroupPolicyManager) flatten(policy iampolicymanagementv1.V2PolicyTemplateMetaData) (map[string]interface{}, error) {
+	// Conditions, patterns and resource tags are not part of the policy schema. Matching such a
+	// policy by its roles and attributes alone would adopt it as an unconditional policy.
+	if policy.Rule != nil || flex.StringValue(policy.Pattern) != "" || (policy.Resource != nil && len(policy.Resource.Tags) > 0) {
+		return nil, fmt.Errorf("[ERROR] Policy %s of access group %s has rule conditions, a pattern or resource tags, which ibm_iam_access_group_policies does not support. Use ibm_iam_access_group_policy for the policies of this access group", flex.StringValue(policy.ID), m.accessGroupID)
+	}
+	attributes := []map[string]interface{}{}
+	if policy.Resource != nil {
+		for _, a := range policy.Resource.Attributes {
+			if flex.StringValue(a.Key) == "accountId" {
+				continue
+			}
+			attributes = append(attributes, map[string]interface{}{
+				"name":     flex.StringValue(a.Key),
+				"value":    fmt.Sprint(a.Value),
+				"operator": flex.StringValue(a.Operator),
+			})
+		}
+	}
+
+	supported, err := m.supportedRoles(policy.Resource)
+	if err != nil {
+		return nil, err
+	}
+	roles := []string{}
+	if control, ok := policy.Control.(*iampolicymanagementv1.ControlResponse); ok && control.Grant != nil {
+		for _, role := range control.Grant.Roles {
+			name := flex.StringValue(role.RoleID)
+			if r, err := flex.FindRoleByCRN(supported, name); err == nil {
+				name = flex.StringValue(r.DisplayName)
+			} else {
+				log.Printf("[WARN] Role %s of policy %s was not found, keeping its CRN", name, flex.StringValue(policy.ID))
+			}
+			roles = append(roles, name)
+		}
+	}
+
+	flattened := map[string]interface{}{
+		"roles":               roles,
+		"resource_attributes": attributes,
+		"description":         "",
+	}
+	if policy.Description != nil {
+		flattened["description"] = *policy.Description
+	}
+	return flattened, nil
+}
+
+func (m *accessGroupPolicyManager) create(policy map[string]interface{}) error {
+	attributes := []iampolicymanagementv1.V2PolicyResourceAttribute{}
+	for _, a := range policy["resource_attributes"].(*schema.Set).List() {
+		attribute := a.(map[string]interface{})
+		var value interface{} = attribute["value"].(string)
+		if attribute["operator"].(string) == "stringExists" {
+			exists, err := strconv.ParseBool(attribute["value"].(string))
+			if err != nil {
+				return fmt.Errorf("[ERROR] The value of the stringExists attribute %s must be true or false", attribute["name"])
+			}
+			value = exists
+		}
+		attributes = append(attributes, iampolicymanagementv1.V2PolicyResourceAttribute{
+			Key:      core.StringPtr(attribute["name"].(string)),
+			Value:    value,
+			Operator: core.StringPtr(attribute["operator"].(string)),
+		})
+	}
+	resource := &iampolicymanagementv1.V2PolicyResource{Attributes: attributes}
+
+	supported, err := m.supportedRoles(resource)
+	if err != nil {
+		return err
+	}
+	roles, err := flex.GetRolesFromRoleNames(flex.ExpandStringList(policy["roles"].(*schema.Set).List()), supported)
+	if err != nil {
+		return err
+	}
+
+	resource.Attributes = append(resource.Attributes, iampolicymanagementv1.V2PolicyResourceAttribute{
+		Key:      core.StringPtr("accountId"),
+		Value:    core.StringPtr(m.accountID),
+		Operator: core.StringPtr("stringEquals"),
+	})
+	createPolicyOptions := m.client.NewCreateV2PolicyOptions(
+		&iampolicymanagementv1.Control{
+			Grant: &iampolicymanagementv1.Grant{Roles: flex.MapPolicyRolesToRoles(roles)},
+		},
+		"access",
+	)
+	createPolicyOptions.SetSubject(&iampolicymanagementv1.V2PolicySubject{
+		Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{
+			{
+				Key:      core.StringPtr("access_group_id"),
+				Value:    &m.accessGroupID,
+				Operator: core.StringPtr("stringEquals"),
+			},
+		},
+	})
+	createPolicyOptions.SetResource(resource)
+	if description := policy["description"].(string); description != "" {
+		createPolicyOptions.SetDescription(description)
+	}
+
+	_, response, err := m.client.CreateV2PolicyWithContext(m.ctx, createPolicyOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating policy for access group %s: %s\n%s", m.accessGroupID, err, response)
+	}
+	return nil
+}
+
+func (m *accessGroupPolicyManager) delete(policyID string) error {
+	log.Printf("[INFO] Deleting policy %s of access group %s", policyID, m.accessGroupID)
+	response, err := m.client.DeleteV2PolicyWithContext(m.ctx, m.client.NewDeleteV2PolicyOptions(policyID))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting policy %s of access group %s: %s\n%s", policyID, m.accessGroupID, err, response)
+	}
+	return nil
+}
+
+// supportedRoles returns the roles that can be granted on a policy resource, with the same
+// service selection as the ibm_iam_access_group_policy resource.
+func (m *accessGroupPolicyManager) supportedRoles(resource *iampolicymanagementv1.V2PolicyResource) ([]iampolicymanagementv1.PolicyRole, error) {
+	var serviceName, serviceType, resourceType, serviceGroupID string
+	if resource != nil {
+		for _, a := range resource.Attributes {
+			value, _ := a.Value.(string)
+			switch flex.StringValue(a.Key) {
+			case "serviceName":
+				serviceName = value
+			case "serviceType":
+				serviceType = value
+			case "resourceType":
+				resourceType = value
+			case "service_group_id":
+				serviceGroupID = value
+			}
+		}
+	}
+	if serviceName == "" && resourceType == "resource-group" {
+		serviceName = "resource-controller"
+	}
+	if serviceName == "" && serviceType == "" && serviceGroupID == "" {
+		serviceName = "alliamserviceroles"
+	}
+
+	cacheKey := serviceName + "/" + serviceGroupID
+	if roles, ok := m.roles[cacheKey]; ok {
+		return roles, nil
+	}
+	listRolesOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:  &m.accountID,
+		PolicyType: core.StringPtr("access"),
+	}
+	if serviceName != "" {
+		listRolesOptions.ServiceName = &serviceName
+	}
+	if serviceGroupID != "" {
+		listRolesOptions.ServiceGroupID = &serviceGroupID
+	}
+	roleList, response, err := m.client.ListRolesWithContext(m.ctx, listRolesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing roles: %s\n%s", err, response)
+	}
+	m.roles[cacheKey] = flex.MapRoleListToPolicyRoles(*roleList)
+	return m.roles[cacheKey], nil
+}
+
+// accessGroupPolicyKey identifies a policy by its roles, resource attributes and description, so
+// configured policies can be matched with the policies of the access group.
+func accessGroupPolicyKey(policy map[string]interface{}) string {
+	var roles []string
+	switch r := policy["roles"].(type) {
+	case *schema.Set:
+		roles = flex.ExpandStringList(r.List())
+	case []string:
+		roles = append(roles, r...)
+	}
+	sort.Strings(roles)
+
+	var attributes []string
+	switch a := policy["resource_attributes"].(type) {
+	case *schema.Set:
+		for _, attribute := range a.List() {
+			attribute := attribute.(map[string]interface{})
+			attributes = append(attributes, fmt.Sprintf("%s %s %s", attribute["name"], attribute["operator"], attribute["value"]))
+		}
+	case []map[string]interface{}:
+		for _, attribute := range a {
+			attributes = append(attributes, fmt.Sprintf("%s %s %s", attribute["name"], attribute["operator"], attribute["value"]))
+		}
+	}
+	sort.Strings(attributes)
+
+	return strings.Join(roles, ",") + "|" + strings.Join(attributes, ",") + "|" + fmt.Sprint(policy["description"])
+}
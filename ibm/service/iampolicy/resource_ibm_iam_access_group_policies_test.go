@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessGroupPolicies_Basic(t *testing.T) {
+	var accessGroupID string
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "1"),
+					testAccCheckIBMIAMAccessGroupPoliciesGroupID("ibm_iam_access_group_policies.policies", &accessGroupID),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "2"),
+				),
+			},
+			{
+				// A policy created outside of Terraform is reported by the plan and removed on apply.
+				PreConfig: func() { testAccCreateUnmanagedAccessGroupPolicy(t, accessGroupID) },
+				Config:    testAccCheckIBMIAMAccessGroupPoliciesUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_access_group_policies.policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesGroupID(n string, accessGroupID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*accessGroupID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCreateUnmanagedAccessGroupPolicy(t *testing.T, accessGroupID string) {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		t.Fatal(err)
+	}
+	userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	roleList, _, err := iamPolicyManagementClient.ListRoles(&iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &userDetails.UserAccount,
+		ServiceName: core.StringPtr("kms"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var readerCRN *string
+	for _, role := range roleList.SystemRoles {
+		if *role.DisplayName == "Reader" {
+			readerCRN = role.CRN
+		}
+	}
+	for _, role := range roleList.ServiceRoles {
+		if *role.DisplayName == "Reader" {
+			readerCRN = role.CRN
+		}
+	}
+	if readerCRN == nil {
+		t.Fatal("Reader role of kms not found")
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreateV2PolicyOptions(
+		&iampolicymanagementv1.Control{
+			Grant: &iampolicymanagementv1.Grant{Roles: []iampolicymanagementv1.Roles{{RoleID: readerCRN}}},
+		},
+		"access",
+	)
+	createPolicyOptions.SetSubject(&iampolicymanagementv1.V2PolicySubject{
+		Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{
+			{Key: core.StringPtr("access_group_id"), Value: &accessGroupID, Operator: core.StringPtr("stringEquals")},
+		},
+	})
+	createPolicyOptions.SetResource(&iampolicymanagementv1.V2PolicyResource{
+		Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+			{Key: core.StringPtr("serviceName"), Value: core.StringPtr("kms"), Operator: core.StringPtr("stringEquals")},
+			{Key: core.StringPtr("accountId"), Value: &userDetails.UserAccount, Operator: core.StringPtr("stringEquals")},
+		},
+	})
+	if _, response, err := iamPolicyManagementClient.CreateV2Policy(createPolicyOptions); err != nil {
+		t.Fatalf("Error creating unmanaged policy: %s\n%s", err, response)
+	}
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_access_group_policies" {
+			continue
+		}
+		accessGroupID := rs.Primary.ID
+		policies, response, err := iamPolicyManagementClient.ListV2Policies(&iampolicymanagementv1.ListV2PoliciesOptions{
+			AccountID:     &userDetails.UserAccount,
+			AccessGroupID: &accessGroupID,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error listing policies of access group %s: %s", accessGroupID, err)
+		}
+		if len(policies.Policies) > 0 {
+			return fmt.Errorf("Access group %s still has %d policies", accessGroupID, len(policies.Policies))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesBasic(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_access_group" "accgrp" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_policies" "policies" {
+		access_group_id = ibm_iam_access_group.accgrp.id
+
+		policy {
+			roles = ["Viewer"]
+			resource_attributes {
+				name  = "serviceName"
+				value = "cloud-object-storage"
+			}
+		}
+	}
+	`, name)
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesUpdate(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_access_group" "accgrp" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_policies" "policies" {
+		access_group_id = ibm_iam_access_group.accgrp.id
+
+		policy {
+			roles = ["Viewer", "Reader"]
+			resource_attributes {
+				name  = "serviceName"
+				value = "cloud-object-storage"
+			}
+		}
+
+		policy {
+			roles       = ["Viewer"]
+			description = "Read access to Key Protect"
+			resource_attributes {
+				name  = "serviceName"
+				value = "kms"
+			}
+		}
+	}
+	`, name)
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSKeyRegistrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyRegistrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID or CRN",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Key ID of the Key. If neither key_id nor alias is set, the registrations of all the keys of the instance are returned.",
+				ConflictsWith: []string{"alias"},
+			},
+			"alias": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Alias of the Key",
+				ConflictsWith: []string{"key_id"},
+			},
+			"resource_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the registrations by the CRN of the protected resource. The CRN can end with a wildcard (*).",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The registrations of the resources protected by the key.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of the key being used in the registration",
+						},
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource tied to the key registration",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the registration.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the resource that created the registration.",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was created. The date format follows RFC 3339.",
+						},
+						"updated_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the resource that updated the registration.",
+						},
+						"last_updated": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was last updated. The date format follows RFC 3339.",
+						},
+						"prevent_key_deletion": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the registration of the key prevents a deletion.",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version used by the resource.",
+						},
+						"key_version_creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the key version used by the resource was created. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyRegistrationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	api, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("key_id").(string)
+	if v, ok := d.GetOk("alias"); ok {
+		key, err := api.GetKey(context, v.(string))
+		if err != nil {
+			return diag.Errorf("Failed to get Key: %s", err)
+		}
+		id = key.ID
+	}
+
+	registrations, err := api.ListRegistrations(context, id, d.Get("resource_crn").(string))
+	if err != nil {
+		return diag.Errorf("Failed to list key registrations: %s", err)
+	}
+
+	registrationList := make([]map[string]interface{}, 0, len(registrations.Registrations))
+	for _, r := range registrations.Registrations {
+		registration := map[string]interface{}{
+			"key_id":               r.KeyID,
+			"resource_crn":         r.ResourceCrn,
+			"description":          r.Description,
+			"created_by":           r.CreatedBy,
+			"updated_by":           r.UpdatedBy,
+			"prevent_key_deletion": r.PreventKeyDeletion,
+			"key_version_id":       r.KeyVersion.ID,
+		}
+		if r.CreationDate != nil {
+			registration["creation_date"] = r.CreationDate.Format(time.RFC3339)
+		}
+		if r.LastUpdateDate != nil {
+			registration["last_updated"] = r.LastUpdateDate.Format(time.RFC3339)
+		}
+		if r.KeyVersion.CreationDate != nil {
+			registration["key_version_creation_date"] = r.KeyVersion.CreationDate.Format(time.RFC3339)
+		}
+		registrationList = append(registrationList, registration)
+	}
+
+	if id != "" {
+		d.SetId(id)
+	} else {
+		d.SetId(instanceID)
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", id)
+	d.Set("registrations", registrationList)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSDataSourceKeyRegistrations_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	cosInstanceName := fmt.Sprintf("cos_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("bucket-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsDataSourceKeyRegistrationsConfig(instanceName, keyName, cosInstanceName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_key_registrations.test", "registrations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_registrations.test", "registrations.0.key_id", "ibm_kms_key.test", "key_id"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_registrations.test", "registrations.0.resource_crn", "ibm_cos_bucket.smart-us-south", "crn"),
+					resource.TestCheckResourceAttrSet("data.ibm_kms_key_registrations.test", "registrations.0.key_version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsDataSourceKeyRegistrationsConfig(instanceName, keyName, cosInstanceName, bucketName string) string {
+	return testAccCheckIBMKmsResourceRootkeyWithCOSConfig(instanceName, "ibm_kms_key", keyName, cosInstanceName, bucketName) + `
+	data "ibm_kms_key_registrations" "test" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id      = ibm_kms_key.test.key_id
+		depends_on  = [ibm_cos_bucket.smart-us-south]
+	}
+`
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	kp "github.com/IBM/keyprotect-go-client"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kmsKeyVersionsPageLimit is the maximum number of key versions returned by one list call.
+const kmsKeyVersionsPageLimit = 200
+
+func DataSourceIBMKMSKeyVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID or CRN",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Key ID of the Key",
+				ExactlyOneOf: []string{"key_id", "alias"},
+			},
+			"alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Alias of the Key",
+				ExactlyOneOf: []string{"key_id", "alias"},
+			},
+			"all_key_states": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include the versions of the key when the key is not in the Active state.",
+			},
+			"last_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the most recent key version, which is the date of the last rotation of a rotated key. The date format follows RFC 3339.",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the key, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version.",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the key version was created. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	api, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("key_id").(string)
+	if v, ok := d.GetOk("alias"); ok {
+		key, err := api.GetKey(context, v.(string))
+		if err != nil {
+			return diag.Errorf("Failed to get Key: %s", err)
+		}
+		id = key.ID
+	}
+
+	allKeyStates := d.Get("all_key_states").(bool)
+	versions := make([]kp.KeyVersion, 0)
+	for offset := uint32(0); ; offset += kmsKeyVersionsPageLimit {
+		limit := uint32(kmsKeyVersionsPageLimit)
+		page, err := api.ListKeyVersions(context, id, &kp.ListKeyVersionsOptions{
+			Limit:        &limit,
+			Offset:       &offset,
+			AllKeyStates: &allKeyStates,
+		})
+		if err != nil {
+			return diag.Errorf("Failed to list key versions: %s", err)
+		}
+		versions = append(versions, page.KeyVersion...)
+		if len(page.KeyVersion) < kmsKeyVersionsPageLimit {
+			break
+		}
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		if versions[i].CreationDate == nil || versions[j].CreationDate == nil {
+			return versions[j].CreationDate == nil && versions[i].CreationDate != nil
+		}
+		return versions[i].CreationDate.After(*versions[j].CreationDate)
+	})
+
+	versionList := make([]map[string]interface{}, 0, len(versions))
+	for _, version := range versions {
+		versionMap := map[string]interface{}{
+			"id": version.ID,
+		}
+		if version.CreationDate != nil {
+			versionMap["creation_date"] = version.CreationDate.Format(time.RFC3339)
+		}
+		versionList = append(versionList, versionMap)
+	}
+	lastRotationDate := ""
+	if len(versions) > 0 && versions[0].CreationDate != nil {
+		lastRotationDate = versions[0].CreationDate.Format(time.RFC3339)
+	}
+
+	d.SetId(id)
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", id)
+	d.Set("versions", versionList)
+	d.Set("last_rotation_date", lastRotationDate)
+
+	return nil
+}
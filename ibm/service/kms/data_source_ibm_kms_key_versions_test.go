@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSDataSourceKeyVersions_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsDataSourceKeyVersionsConfig(instanceName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_versions.test", "key_id", "ibm_kms_key.test", "key_id"),
+					resource.TestCheckResourceAttr("data.ibm_kms_key_versions.test", "versions.#", "1"),
+					resource.TestCheckResourceAttrSet("data.ibm_kms_key_versions.test", "versions.0.id"),
+					resource.TestCheckResourceAttrSet("data.ibm_kms_key_versions.test", "last_rotation_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsDataSourceKeyVersionsConfig(instanceName, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+
+	data "ibm_kms_key_versions" "test" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id      = ibm_kms_key.test.key_id
+	}
+`, addPrefixToResourceName(instanceName), keyName)
+}
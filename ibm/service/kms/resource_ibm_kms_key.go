@@ -16,7 +16,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	kp "github.com/IBM/keyprotect-go-client"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func suppressKMSInstanceIDDiff(k, old, new string, d *schema.ResourceData) bool {
@@ -39,12 +41,13 @@ func getInstanceIDFromCRN(crn string) string {
 
 func ResourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyCreate,
-		Read:     resourceIBMKmsKeyRead,
-		Update:   resourceIBMKmsKeyUpdate,
-		Delete:   resourceIBMKmsKeyDelete,
-		Exists:   resourceIBMKmsKeyExists,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMKmsKeyCreate,
+		ReadContext:   resourceIBMKmsKeyReadContext,
+		Update:        resourceIBMKmsKeyUpdate,
+		Delete:        resourceIBMKmsKeyDelete,
+		Exists:        resourceIBMKmsKeyExists,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMKmsKeyRotationCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -143,6 +146,24 @@ func ResourceIBMKmskey() *schema.Resource {
 				Computed:    true,
 				Description: "Key protect or hpcs instance CRN",
 			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key material was last rotated, or the creation date of the key if it was never rotated",
+			},
+			"enforce_rotation_before_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of days since the last rotation of a root key before the plan reports the key as overdue for rotation",
+			},
+			"enforce_rotation_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warn",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"warn", "error"}),
+				Description:  "Whether a key overdue for rotation is reported as a plan warning or fails the plan: warn or error",
+			},
 
 			"registrations": {
 				Type:        schema.TypeList,
@@ -224,6 +245,52 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceIBMKmsKeyUpdate(d, meta)
 }
 
+func resourceIBMKmsKeyReadContext(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceIBMKmsKeyRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Id() == "" || d.Get("enforce_rotation_action").(string) != "warn" {
+		return nil
+	}
+	if overdue := kmsKeyRotationOverdue(d.Get("standard_key").(bool), d.Get("last_rotate_date").(string), d.Get("enforce_rotation_before_days").(int)); overdue != "" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Key rotation overdue",
+			Detail:   fmt.Sprintf("The key %s %s", d.Get("key_id").(string), overdue),
+		}}
+	}
+	return nil
+}
+
+// resourceIBMKmsKeyRotationCustomizeDiff fails the plan of a key that is overdue for rotation when
+// enforce_rotation_action is error.
+func resourceIBMKmsKeyRotationCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("enforce_rotation_action").(string) != "error" {
+		return nil
+	}
+	if overdue := kmsKeyRotationOverdue(diff.Get("standard_key").(bool), diff.Get("last_rotate_date").(string), diff.Get("enforce_rotation_before_days").(int)); overdue != "" {
+		return flex.FmtErrorf("[ERROR] The key %s %s", diff.Get("key_id").(string), overdue)
+	}
+	return nil
+}
+
+// kmsKeyRotationOverdue describes why a root key is overdue for rotation, or returns an empty
+// string when it was rotated in the last maxDays days. Standard keys cannot be rotated.
+func kmsKeyRotationOverdue(standardKey bool, lastRotateDate string, maxDays int) string {
+	if standardKey || maxDays == 0 || lastRotateDate == "" {
+		return ""
+	}
+	lastRotation, err := time.Parse(time.RFC3339, lastRotateDate)
+	if err != nil {
+		return ""
+	}
+	days := int(time.Since(lastRotation).Hours() / 24)
+	if days < maxDays {
+		return ""
+	}
+	return fmt.Sprintf("was last rotated on %s, %d days ago, which exceeds enforce_rotation_before_days (%d). Rotate the key, for example with a rotation policy in ibm_kms_key_policies.", lastRotateDate, days, maxDays)
+}
+
 func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	_, err := populateSchemaData(d, meta)
@@ -331,6 +398,11 @@ func setKeyDetails(d *schema.ResourceData, meta interface{}, instanceID string,
 	d.Set("instance_id", instanceID)
 	d.Set("instance_crn", instanceCRN)
 	d.Set("key_id", key.ID)
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	} else if key.CreationDate != nil {
+		d.Set("last_rotate_date", key.CreationDate.Format(time.RFC3339))
+	}
 	d.Set("standard_key", key.Extractable)
 	d.Set("payload", d.Get("payload"))
 	d.Set("description", key.Description)
@@ -154,6 +154,52 @@ func TestAccIBMKMSResource_InvalidExpDate(t *testing.T) {
 	})
 }
 
+func TestAccIBMKMSResource_EnforceRotation(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// A key created in the same run is never overdue for rotation.
+				Config: testAccCheckIBMKmsResourceEnforceRotationConfig(instanceName, keyName, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_key.test", "last_rotate_date"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "enforce_rotation_before_days", "90"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "enforce_rotation_action", "error"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceEnforceRotationConfig(instanceName, keyName, "warn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "enforce_rotation_action", "warn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsResourceEnforceRotationConfig(instanceName, KeyName, action string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	  }
+	  resource "ibm_kms_key" "test" {
+		instance_id = "${ibm_resource_instance.kms_instance.guid}"
+		key_name = "%s"
+		standard_key = false
+		force_delete = true
+		enforce_rotation_before_days = 90
+		enforce_rotation_action = "%s"
+	}
+`, addPrefixToResourceName(instanceName), KeyName, action)
+}
+
 func testAccCheckIBMKmsResourceConfig(instanceName, resource, KeyName string, standard_key bool) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "kms_instance" {
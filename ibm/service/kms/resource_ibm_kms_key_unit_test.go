@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKmsKeyRotationOverdue(t *testing.T) {
+	rotatedAgo := func(d time.Duration) string {
+		return time.Now().UTC().Add(-d).Format(time.RFC3339)
+	}
+	day := 24 * time.Hour
+
+	cases := []struct {
+		name        string
+		standardKey bool
+		lastRotate  string
+		maxDays     int
+		overdue     bool
+	}{
+		{"standard key", true, rotatedAgo(400 * day), 90, false},
+		{"enforcement disabled", false, rotatedAgo(400 * day), 0, false},
+		{"never rotated", false, "", 90, false},
+		{"unparsable date", false, "2026-01-02 03:04:05", 90, false},
+		{"recently rotated", false, rotatedAgo(10 * day), 90, false},
+		{"day before the boundary", false, rotatedAgo(90*day - time.Hour), 90, false},
+		{"boundary day", false, rotatedAgo(90*day + time.Hour), 90, true},
+		{"overdue", false, rotatedAgo(200 * day), 90, true},
+	}
+	for _, c := range cases {
+		message := kmsKeyRotationOverdue(c.standardKey, c.lastRotate, c.maxDays)
+		if c.overdue != (message != "") {
+			t.Errorf("%s: expected overdue %t, got %q", c.name, c.overdue, message)
+		}
+	}
+
+	message := kmsKeyRotationOverdue(false, rotatedAgo(200*day+time.Hour), 90)
+	if !strings.Contains(message, "200 days ago") || !strings.Contains(message, "enforce_rotation_before_days (90)") {
+		t.Errorf("unexpected message: %s", message)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+var (
+	_ action.Action                   = &piVolumeGroupFailbackAction{}
+	_ action.ActionWithConfigure      = &piVolumeGroupFailbackAction{}
+	_ action.ActionWithValidateConfig = &piVolumeGroupFailbackAction{}
+)
+
+// Replication states of the consistency group from which replication can be restarted.
+var volumeGroupFailbackStates = []string{State_Idling, State_IdlingDisconnected, State_ConsistentStopped, State_InconsistentStopped}
+
+// Replication states of the consistency group once replication is running again.
+var volumeGroupReplicatingStates = []string{State_ConsistentSynchronized, State_ConsistentCopying, State_InconsistentCopying}
+
+func NewPIVolumeGroupFailbackAction() action.Action {
+	return &piVolumeGroupFailbackAction{}
+}
+
+type piVolumeGroupFailbackAction struct {
+	session *ibmpisession.IBMPISession
+}
+
+type volumeGroupFailbackModel struct {
+	CloudInstanceID types.String `tfsdk:"pi_cloud_instance_id"`
+	VolumeGroupID   types.String `tfsdk:"pi_volume_group_id"`
+	Source          types.String `tfsdk:"pi_source"`
+	WaitForSync     types.Bool   `tfsdk:"pi_wait_for_sync"`
+	Timeout         types.String `tfsdk:"pi_timeout"`
+}
+
+func (a *piVolumeGroupFailbackAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_pi_volume_group_failback"
+}
+
+func (a *piVolumeGroupFailbackAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts replication of a failed over volume group. The action verifies that replication is stopped, starts it from the master or auxiliary volumes, and verifies that the consistency group is replicating afterwards. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the workspace that owns the volume group.",
+			},
+			Arg_VolumeGroupID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the volume group to fail back.",
+			},
+			Arg_Source: schema.StringAttribute{
+				Required:    true,
+				Description: "The volumes that replication starts from, `aux` to replicate the changes made after the failover back to the master volumes, or `master` to discard them.",
+			},
+			Arg_WaitForSync: schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action waits until the consistency group is consistent_synchronized. Default: false",
+			},
+			Arg_Timeout: schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the failback to complete, for example `30m` or `1h`. If not specified, defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *piVolumeGroupFailbackAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config volumeGroupFailbackModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Source.IsNull() && !config.Source.IsUnknown() {
+		if source := config.Source.ValueString(); source != Master && source != Aux {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(Arg_Source),
+				"Invalid Source",
+				fmt.Sprintf("%s must be %s or %s, got '%s'.", Arg_Source, Master, Aux, source),
+			)
+		}
+	}
+	validateVolumeGroupActionTimeout(config.Timeout, resp)
+}
+
+func (a *piVolumeGroupFailbackAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session = configureVolumeGroupAction(req, resp)
+}
+
+func (a *piVolumeGroupFailbackAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config volumeGroupFailbackModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudInstanceID := config.CloudInstanceID.ValueString()
+	vgID := config.VolumeGroupID.ValueString()
+	source := config.Source.ValueString()
+	timeout := volumeGroupActionTimeout(config.Timeout)
+	client := instance.NewIBMPIVolumeGroupClient(ctx, a.session, cloudInstanceID)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Verifying replication state of volume group '%s'...", vgID),
+	})
+	if _, err := checkVolumeGroupReplicationState(client, vgID, volumeGroupFailbackStates, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"Volume Group Not Ready for Failback",
+			fmt.Sprintf("Volume group '%s' cannot be failed back: %s", vgID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting replication of volume group '%s' from the %s volumes...", vgID, source),
+	})
+	body := &models.VolumeGroupAction{
+		Start: &models.VolumeGroupActionStart{Source: sl.String(source)},
+	}
+	if _, err := client.VolumeGroupAction(vgID, body); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Initiate Volume Group Failback",
+			fmt.Sprintf("Failed to start replication of volume group '%s': %s", vgID, err.Error()),
+		)
+		return
+	}
+
+	target := volumeGroupReplicatingStates
+	if config.WaitForSync.ValueBool() {
+		target = []string{State_ConsistentSynchronized}
+	}
+	result, err := isWaitForIBMPIVolumeGroupReplicationState(ctx, client, vgID, target, timeout, resp.SendProgress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Volume Group Failback Failed",
+			fmt.Sprintf("Failed waiting for volume group '%s' to replicate: %s", vgID, err.Error()),
+		)
+		return
+	}
+
+	if details, ok := result.(*models.VolumeGroupStorageDetails); ok && details.PrimaryRole != "" && details.PrimaryRole != source {
+		resp.Diagnostics.AddError(
+			"Volume Group Failback Failed",
+			fmt.Sprintf("Volume group '%s' is replicating from the %s volumes instead of the %s volumes.", vgID, details.PrimaryRole, source),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Volume group '%s' is replicating from the %s volumes", vgID, source),
+	})
+}
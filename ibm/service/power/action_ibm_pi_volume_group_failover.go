@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+var (
+	_ action.Action                   = &piVolumeGroupFailoverAction{}
+	_ action.ActionWithConfigure      = &piVolumeGroupFailoverAction{}
+	_ action.ActionWithValidateConfig = &piVolumeGroupFailoverAction{}
+)
+
+// Replication states of the consistency group from which a failover can be started.
+var volumeGroupFailoverStates = []string{State_ConsistentSynchronized, State_ConsistentCopying, State_ConsistentStopped, State_ConsistentDisconnected}
+
+// Replication states of the consistency group once the auxiliary volumes are accessible.
+var volumeGroupFailedOverStates = []string{State_Idling, State_IdlingDisconnected}
+
+func NewPIVolumeGroupFailoverAction() action.Action {
+	return &piVolumeGroupFailoverAction{}
+}
+
+type piVolumeGroupFailoverAction struct {
+	session *ibmpisession.IBMPISession
+}
+
+type volumeGroupFailoverModel struct {
+	CloudInstanceID types.String `tfsdk:"pi_cloud_instance_id"`
+	VolumeGroupID   types.String `tfsdk:"pi_volume_group_id"`
+	Timeout         types.String `tfsdk:"pi_timeout"`
+}
+
+func (a *piVolumeGroupFailoverAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_pi_volume_group_failover"
+}
+
+func (a *piVolumeGroupFailoverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fails over a replicated volume group to the workspace that owns its auxiliary volumes. The action verifies that the consistency group holds a consistent copy, stops replication with read and write access to the auxiliary volumes, and verifies that replication is idling afterwards. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the workspace that owns the auxiliary volume group.",
+			},
+			Arg_VolumeGroupID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the auxiliary volume group to fail over.",
+			},
+			Arg_Timeout: schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the failover to complete, for example `30m` or `1h`. If not specified, defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *piVolumeGroupFailoverAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config volumeGroupFailoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateVolumeGroupActionTimeout(config.Timeout, resp)
+}
+
+func (a *piVolumeGroupFailoverAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session = configureVolumeGroupAction(req, resp)
+}
+
+func (a *piVolumeGroupFailoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config volumeGroupFailoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudInstanceID := config.CloudInstanceID.ValueString()
+	vgID := config.VolumeGroupID.ValueString()
+	timeout := volumeGroupActionTimeout(config.Timeout)
+	client := instance.NewIBMPIVolumeGroupClient(ctx, a.session, cloudInstanceID)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Verifying replication state of volume group '%s'...", vgID),
+	})
+	vg, err := client.GetDetails(vgID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Get Volume Group",
+			fmt.Sprintf("Failed to get volume group '%s' in workspace '%s': %s", vgID, cloudInstanceID, err.Error()),
+		)
+		return
+	}
+	if vg.Auxiliary == nil || !*vg.Auxiliary {
+		resp.Diagnostics.AddError(
+			"Volume Group Is Not Auxiliary",
+			fmt.Sprintf("Volume group '%s' holds the master volumes. Run the failover from the workspace that owns the auxiliary volume group.", vgID),
+		)
+		return
+	}
+	if _, err := checkVolumeGroupReplicationState(client, vgID, volumeGroupFailoverStates, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"Volume Group Not Ready for Failover",
+			fmt.Sprintf("Volume group '%s' cannot be failed over: %s", vgID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Stopping replication of volume group '%s' with access to the auxiliary volumes...", vgID),
+	})
+	body := &models.VolumeGroupAction{
+		Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)},
+	}
+	if _, err := client.VolumeGroupAction(vgID, body); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Initiate Volume Group Failover",
+			fmt.Sprintf("Failed to stop replication of volume group '%s': %s", vgID, err.Error()),
+		)
+		return
+	}
+
+	if _, err := isWaitForIBMPIVolumeGroupReplicationState(ctx, client, vgID, volumeGroupFailedOverStates, timeout, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"Volume Group Failover Failed",
+			fmt.Sprintf("Failed waiting for volume group '%s' to fail over: %s", vgID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Volume group '%s' failed over, auxiliary volumes are accessible in workspace '%s'", vgID, cloudInstanceID),
+	})
+}
+
+// configureVolumeGroupAction returns the Power session from the provider data of a volume group action.
+func configureVolumeGroupAction(req action.ConfigureRequest, resp *action.ConfigureResponse) *ibmpisession.IBMPISession {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return nil
+	}
+
+	sess, err := session.IBMPISession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Power Systems Client",
+			"An unexpected error occurred when creating the Power Systems client.\n\n"+
+				"Power Systems Client Error: "+err.Error(),
+		)
+		return nil
+	}
+	return sess
+}
+
+func validateVolumeGroupActionTimeout(timeout types.String, resp *action.ValidateConfigResponse) {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(timeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(Arg_Timeout),
+			"Invalid Timeout Format",
+			fmt.Sprintf("Failed to parse timeout '%s': %s. Expected format like '30m' or '1h'.", timeout.ValueString(), err.Error()),
+		)
+	}
+}
+
+func volumeGroupActionTimeout(timeout types.String) time.Duration {
+	if !timeout.IsNull() {
+		if d, err := time.ParseDuration(timeout.ValueString()); err == nil {
+			return d
+		}
+	}
+	return 30 * time.Minute
+}
+
+// checkVolumeGroupReplicationState returns the live storage details of the volume group when the
+// consistency group is in one of the expected replication states.
+func checkVolumeGroupReplicationState(client *instance.IBMPIVolumeGroupClient, id string, expected []string, sendProgress func(action.InvokeProgressEvent)) (*models.VolumeGroupStorageDetails, error) {
+	details, err := client.GetVolumeGroupLiveDetails(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replication details: %w", err)
+	}
+	sendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Consistency group %s: state %s, primary role %s, %d volumes", sl.Get(details.ConsistencyGroupName, ""), details.State, details.PrimaryRole, details.NumOfvols),
+	})
+	if !slices.Contains(expected, details.State) {
+		return details, fmt.Errorf("replication state is %s, expected one of %s", details.State, strings.Join(expected, ", "))
+	}
+	return details, nil
+}
+
+func isWaitForIBMPIVolumeGroupReplicationState(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, target []string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) replication state to be one of %s.", id, strings.Join(target, ", "))
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Retry, State_Updating},
+		Target:     target,
+		Refresh:    isIBMPIVolumeGroupReplicationStateRefreshFunc(client, id, target, sendProgress),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupReplicationStateRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string, target []string, sendProgress func(action.InvokeProgressEvent)) retry.StateRefreshFunc {
+	lastState := ""
+	return func() (interface{}, string, error) {
+		vg, err := client.GetDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if vg.Status == State_Error {
+			return vg, vg.Status, fmt.Errorf("volume group is in %s status", State_Error)
+		}
+		if vg.Status != State_Available {
+			return vg, State_Updating, nil
+		}
+
+		details, err := client.GetVolumeGroupLiveDetails(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if details.State != lastState {
+			sendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Volume group replication state: %s", details.State),
+			})
+			lastState = details.State
+		}
+		if slices.Contains(target, details.State) {
+			return details, details.State, nil
+		}
+		return details, State_Updating, nil
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+)
+
+// TestAccIBMPIVolumeGroupFailoverActionBasic fails over the auxiliary volume group
+// PI_VOLUME_GROUP_ID and fails it back to the master volumes.
+func TestAccIBMPIVolumeGroupFailoverActionBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailoverActionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupReplicationState(power.State_Idling, power.State_IdlingDisconnected),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGroupFailbackActionConfig(power.Master),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeGroupReplicationState(power.State_ConsistentSynchronized, power.State_ConsistentCopying, power.State_InconsistentCopying),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIVolumeGroupFailbackActionInvalidSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIVolumeGroupFailbackActionConfig("primary"),
+				ExpectError: regexp.MustCompile("Invalid Source"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeGroupReplicationState(states ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPIVolumeGroupClient(context.Background(), sess, acc.Pi_cloud_instance_id)
+		details, err := client.GetVolumeGroupLiveDetails(acc.Pi_volume_group_id)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(states, details.State) {
+			return fmt.Errorf("volume group replication state is %s, expected one of %v", details.State, states)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIVolumeGroupFailoverActionConfig() string {
+	return fmt.Sprintf(`
+		action "ibm_pi_volume_group_failover" "failover" {
+			config {
+				pi_cloud_instance_id = "%[1]s"
+				pi_volume_group_id   = "%[2]s"
+			}
+		}
+
+		resource "terraform_data" "failover" {
+			input = "%[2]s"
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.ibm_pi_volume_group_failover.failover]
+				}
+			}
+		}
+	`, acc.Pi_cloud_instance_id, acc.Pi_volume_group_id)
+}
+
+func testAccCheckIBMPIVolumeGroupFailbackActionConfig(source string) string {
+	return fmt.Sprintf(`
+		action "ibm_pi_volume_group_failback" "failback" {
+			config {
+				pi_cloud_instance_id = "%[1]s"
+				pi_source            = "%[3]s"
+				pi_volume_group_id   = "%[2]s"
+			}
+		}
+
+		resource "terraform_data" "failback" {
+			input = "%[2]s"
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.ibm_pi_volume_group_failback.failback]
+				}
+			}
+		}
+	`, acc.Pi_cloud_instance_id, acc.Pi_volume_group_id, source)
+}
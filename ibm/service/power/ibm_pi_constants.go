@@ -10,6 +10,7 @@ const (
 	Arg_AffinityPolicy                       = "pi_affinity_policy"
 	Arg_AffinityVolume                       = "pi_affinity_volume"
 	Arg_AllowRemoteRestart                   = "pi_allow_remote_restart"
+	Arg_AllowRestartForResize                = "pi_allow_restart_for_resize"
 	Arg_AntiAffinityInstances                = "pi_anti_affinity_instances"
 	Arg_AntiAffinityVolumes                  = "pi_anti_affinity_volumes"
 	Arg_ARPBroadcast                         = "pi_arp_broadcast"
@@ -159,6 +160,7 @@ const (
 	Arg_SnapShotName                         = "pi_snap_shot_name"
 	Arg_SnapshotName                         = "pi_snapshot_name"
 	Arg_SoftwareTier                         = "pi_software_tier"
+	Arg_Source                               = "pi_source"
 	Arg_SourceChecksum                       = "pi_source_checksum"
 	Arg_SourceCRN                            = "pi_source_crn"
 	Arg_SourcePort                           = "pi_source_port"
@@ -176,6 +178,7 @@ const (
 	Arg_SysType                              = "pi_sys_type"
 	Arg_Target                               = "pi_target"
 	Arg_TargetStorageTier                    = "pi_target_storage_tier"
+	Arg_Timeout                              = "pi_timeout"
 	Arg_Type                                 = "pi_type"
 	Arg_UserData                             = "pi_user_data"
 	Arg_UserTags                             = "pi_user_tags"
@@ -202,6 +205,7 @@ const (
 	Arg_VPMEMVolumeID                        = "pi_vpmem_volume_id"
 	Arg_VPMEMVolumes                         = "pi_vpmem_volumes"
 	Arg_VTL                                  = "vtl"
+	Arg_WaitForSync                          = "pi_wait_for_sync"
 
 	// Attributes
 	Attr_Access                              = "access"
@@ -480,6 +484,7 @@ const (
 	Attr_ReservedCores                       = "reserved_cores"
 	Attr_ReservedMemory                      = "reserved_memory"
 	Attr_Reset                               = "reset"
+	Attr_ResizePlan                          = "resize_plan"
 	Attr_ResultsOnboardedVolumes             = "results_onboarded_volumes"
 	Attr_ResultsVolumeOnboardingFailures     = "results_volume_onboarding_failures"
 	Attr_RouteFilterID                       = "route_filter_id"
@@ -649,6 +654,7 @@ const (
 	L2                         = "L2"
 	L3BGP                      = "L3BGP"
 	L3Static                   = "L3Static"
+	Live                       = "live"
 	Master                     = "master"
 	MaxVolumeSupport           = "maxVolumeSupport"
 	NAG                        = "network-address-group"
@@ -663,6 +669,7 @@ const (
 	Private                    = "private"
 	Public                     = "public"
 	PubVlan                    = "pub-vlan"
+	Restart                    = "restart"
 	SAP                        = "SAP"
 	Shared                     = "shared"
 	Soft                       = "soft"
@@ -688,49 +695,57 @@ const (
 	Action_Stop              = "stop"
 
 	// States
-	NotFound                 = "not found"
-	State_Active             = "active"
-	State_ACTIVE             = "ACTIVE"
-	State_Added              = "added"
-	State_Adding             = "adding"
-	State_Available          = "available"
-	State_Build              = "build"
-	State_Building           = "building"
-	State_Completed          = "completed"
-	State_Configuring        = "configuring"
-	State_Creating           = "creating"
-	State_Deleted            = "deleted"
-	State_Deleting           = "deleting"
-	State_Detaching          = "detaching"
-	State_Down               = "down"
-	State_Error              = "error"
-	State_ERROR              = "ERROR"
-	State_Failed             = "failed"
-	State_Found              = "Found"
-	State_Inactive           = "inactive"
-	State_InProgress         = "in progress"
-	State_inProgress         = "inProgress"
-	State_InUse              = "in-use"
-	State_NotFound           = "not found"
-	State_Pending            = "pending"
-	State_PENDING            = "PENDING"
-	State_PendingReclamation = "pending_reclamation"
-	State_Provisioning       = "provisioning"
-	State_Queued             = "queued"
-	State_ReadyForProcessing = "readyForProcessing"
-	State_Removed            = "removed"
-	State_Removing           = "removing"
-	State_Resize             = "resize"
-	State_RESIZE             = "RESIZE"
-	State_Retry              = "retry"
-	State_Running            = "running"
-	State_Shutoff            = "shutoff"
-	State_SHUTOFF            = "SHUTOFF"
-	State_Stopping           = "stopping"
-	State_Up                 = "up"
-	State_Updating           = "updating"
-	State_VerifyResize       = "verify_resize"
-	State_Waiting            = "waiting"
+	NotFound                     = "not found"
+	State_Active                 = "active"
+	State_ACTIVE                 = "ACTIVE"
+	State_Added                  = "added"
+	State_Adding                 = "adding"
+	State_Available              = "available"
+	State_Build                  = "build"
+	State_Building               = "building"
+	State_Completed              = "completed"
+	State_Configuring            = "configuring"
+	State_ConsistentCopying      = "consistent_copying"
+	State_ConsistentDisconnected = "consistent_disconnected"
+	State_ConsistentStopped      = "consistent_stopped"
+	State_ConsistentSynchronized = "consistent_synchronized"
+	State_Creating               = "creating"
+	State_Deleted                = "deleted"
+	State_Deleting               = "deleting"
+	State_Detaching              = "detaching"
+	State_Down                   = "down"
+	State_Error                  = "error"
+	State_ERROR                  = "ERROR"
+	State_Failed                 = "failed"
+	State_Found                  = "Found"
+	State_Idling                 = "idling"
+	State_IdlingDisconnected     = "idling_disconnected"
+	State_Inactive               = "inactive"
+	State_InconsistentCopying    = "inconsistent_copying"
+	State_InconsistentStopped    = "inconsistent_stopped"
+	State_InProgress             = "in progress"
+	State_inProgress             = "inProgress"
+	State_InUse                  = "in-use"
+	State_NotFound               = "not found"
+	State_Pending                = "pending"
+	State_PENDING                = "PENDING"
+	State_PendingReclamation     = "pending_reclamation"
+	State_Provisioning           = "provisioning"
+	State_Queued                 = "queued"
+	State_ReadyForProcessing     = "readyForProcessing"
+	State_Removed                = "removed"
+	State_Removing               = "removing"
+	State_Resize                 = "resize"
+	State_RESIZE                 = "RESIZE"
+	State_Retry                  = "retry"
+	State_Running                = "running"
+	State_Shutoff                = "shutoff"
+	State_SHUTOFF                = "SHUTOFF"
+	State_Stopping               = "stopping"
+	State_Up                     = "up"
+	State_Updating               = "updating"
+	State_VerifyResize           = "verify_resize"
+	State_Waiting                = "waiting"
 
 	// Timeout values
 	Timeout_Active   = 2 * time.Minute
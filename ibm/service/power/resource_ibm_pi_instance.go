@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"maps"
+	"math"
 	"regexp"
 	"strings"
 	"time"
@@ -123,6 +124,8 @@ func ResourceIBMPIInstance() *schema.Resource {
 				}
 				return nil
 			},
+
+			resourceIBMPIInstanceResizeCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -151,6 +154,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
+			Arg_AllowRestartForResize: {
+				Default:     true,
+				Description: "Indicates whether the instance may be stopped, resized and started again when a change to pi_processors, pi_memory or pi_proc_type cannot be applied while the instance is running. Set to false to fail the plan instead.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			Arg_AntiAffinityInstances: {
 				ConflictsWith: []string{Arg_AntiAffinityVolumes},
 				Description:   "List of pvmInstances to base storage anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
@@ -674,6 +683,11 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Progress of the operation",
 				Type:        schema.TypeFloat,
 			},
+			Attr_ResizePlan: {
+				Computed:    true,
+				Description: "How the last planned change to processors, memory or processor type is applied, live (within the bounds of the instance) or restart (the instance must be stopped for the change, which is stopped, resized and started again if it is running).",
+				Type:        schema.TypeString,
+			},
 			Attr_SharedProcessorPoolID: {
 				Computed:    true,
 				Description: "Shared Processor Pool ID the instance is deployed on",
@@ -1054,6 +1068,9 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if strings.ToLower(status) == State_Shutoff {
 			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
 		} else {
+			if !d.Get(Arg_AllowRestartForResize).(bool) {
+				return diag.Errorf("the change to %s requires a restart of the lpar, set %s to true to allow it", Arg_ProcType, Arg_AllowRestartForResize)
+			}
 			err := stopLparForResourceChange(ctx, client, instanceID, d)
 			if err != nil {
 				return diag.FromErr(err)
@@ -1102,21 +1119,20 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	// Start of the change for Memory and Processors
 	if d.HasChange(Arg_Memory) || d.HasChange(Arg_Processors) {
-
+		minMemLpar := d.Get(Attr_MinMemory).(float64)
 		maxMemLpar := d.Get(Attr_MaxMemory).(float64)
+		minCPULpar := d.Get(Attr_MinProcessors).(float64)
 		maxCPULpar := d.Get(Attr_MaxProcessors).(float64)
-
-		if mem > maxMemLpar || procs > maxCPULpar {
-			log.Printf("Will require a shutdown to perform the change")
-		} else {
-			log.Printf("maxMemLpar is set to %f", maxMemLpar)
-			log.Printf("maxCPULpar is set to %f", maxCPULpar)
-		}
+		log.Printf("memory bounds of the lpar are %f - %f", minMemLpar, maxMemLpar)
+		log.Printf("processor bounds of the lpar are %f - %f", minCPULpar, maxCPULpar)
 
 		instanceState := d.Get(Attr_Status).(string)
 		log.Printf("the instance state is %s", instanceState)
 
-		if (mem > maxMemLpar || procs > maxCPULpar) && strings.ToLower(instanceState) != State_Shutoff {
+		if instanceResizePlan(mem, procs, minMemLpar, maxMemLpar, minCPULpar, maxCPULpar) == Restart && strings.ToLower(instanceState) != State_Shutoff {
+			if !d.Get(Arg_AllowRestartForResize).(bool) {
+				return diag.Errorf("the change to %s or %s is outside the bounds of the running lpar and requires a restart, set %s to true to allow it", Arg_Memory, Arg_Processors, Arg_AllowRestartForResize)
+			}
 			err = performChangeAndReboot(ctx, client, d, instanceID, mem, procs)
 			if err != nil {
 				return diag.FromErr(err)
@@ -1558,6 +1574,58 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// resourceIBMPIInstanceResizeCustomizeDiff plans a change to processors, memory or processor type
+// against the bounds and health of the lpar, which are refreshed into state before the plan.
+func resourceIBMPIInstanceResizeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v any) error {
+	if diff.Id() == "" || !diff.HasChanges(Arg_Memory, Arg_Processors, Arg_ProcType) {
+		return nil
+	}
+	if !diff.NewValueKnown(Arg_Memory) || !diff.NewValueKnown(Arg_Processors) || !diff.NewValueKnown(Arg_ProcType) {
+		return diff.SetNewComputed(Attr_ResizePlan)
+	}
+	if diff.Get(Attr_HealthStatus).(string) == Warning {
+		return fmt.Errorf("%s, %s and %s cannot be changed while the lpar health is in the %s state", Arg_Processors, Arg_Memory, Arg_ProcType, Warning)
+	}
+
+	mem := diff.Get(Arg_Memory).(float64)
+	procs := diff.Get(Arg_Processors).(float64)
+	procType := diff.Get(Arg_ProcType).(string)
+	if procType == Dedicated && procs != math.Trunc(procs) {
+		return fmt.Errorf("%s must be a whole number when %s is %s, got %v", Arg_Processors, Arg_ProcType, Dedicated, procs)
+	}
+
+	minMem := diff.Get(Attr_MinMemory).(float64)
+	maxMem := diff.Get(Attr_MaxMemory).(float64)
+	minProcs := diff.Get(Attr_MinProcessors).(float64)
+	maxProcs := diff.Get(Attr_MaxProcessors).(float64)
+
+	// The plan is reported even when the instance is shut off, in which case it is not started.
+	shutoff := strings.ToLower(diff.Get(Attr_Status).(string)) == State_Shutoff
+	plan := instanceResizePlan(mem, procs, minMem, maxMem, minProcs, maxProcs)
+	if diff.HasChange(Arg_ProcType) {
+		plan = Restart
+	}
+	if plan == Restart && !shutoff && !diff.Get(Arg_AllowRestartForResize).(bool) {
+		if diff.HasChange(Arg_ProcType) {
+			return fmt.Errorf("the change to %s requires a restart of the lpar, set %s to true to allow it or shut off the instance first", Arg_ProcType, Arg_AllowRestartForResize)
+		}
+		return fmt.Errorf("%s %v and %s %v are outside the bounds of the running lpar (memory %v - %v, processors %v - %v) and require a restart, set %s to true to allow it or shut off the instance first",
+			Arg_Memory, mem, Arg_Processors, procs, minMem, maxMem, minProcs, maxProcs, Arg_AllowRestartForResize)
+	}
+	log.Printf("[DEBUG] resize plan for the lpar (%s) is %s", diff.Id(), plan)
+	return diff.SetNew(Attr_ResizePlan, plan)
+}
+
+// instanceResizePlan returns Live when memory and processors are within the bounds
+// of the lpar and Restart otherwise. Bounds that are not reported are ignored.
+func instanceResizePlan(mem, procs, minMem, maxMem, minProcs, maxProcs float64) string {
+	if (maxMem > 0 && mem > maxMem) || (minMem > 0 && mem < minMem) ||
+		(maxProcs > 0 && procs > maxProcs) || (minProcs > 0 && procs < minProcs) {
+		return Restart
+	}
+	return Live
+}
+
 func isWaitForPIInstanceDeleted(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) (any, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -696,6 +697,68 @@ func TestAccIBMPIInstanceUpdateStoppedState(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceResizeWithRestart(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceResizeConfig(name, "0.25", "2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_instance_name", name),
+					resource.TestCheckResourceAttr(instanceRes, "status", strings.ToUpper(power.State_Active)),
+				),
+			},
+			{
+				Config:      testAccCheckIBMPIInstanceResizeConfig(name, "1", "32", false),
+				ExpectError: regexp.MustCompile("require a restart"),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceResizeConfig(name, "1", "32", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceStatus(instanceRes, strings.ToUpper(power.State_Active)),
+					resource.TestCheckResourceAttr(instanceRes, "pi_memory", "32"),
+					resource.TestCheckResourceAttr(instanceRes, "pi_processors", "1"),
+					resource.TestCheckResourceAttr(instanceRes, "resize_plan", power.Restart),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceResizeConfig(name, proc, memory string, allowRestart bool) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_allow_restart_for_resize = %[7]t
+		pi_cloud_instance_id        = "%[1]s"
+		pi_health_status            = "OK"
+		pi_image_id                 = data.ibm_pi_image.power_image.id
+		pi_instance_name            = "%[2]s"
+		pi_memory                   = "%[6]s"
+		pi_pin_policy               = "none"
+		pi_proc_type                = "shared"
+		pi_processors               = "%[5]s"
+		pi_storage_pool             = data.ibm_pi_image.power_image.storage_pool
+		pi_sys_type                 = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, proc, memory, allowRestart)
+}
+
 func testAccCheckIBMPIActiveInstanceConfigUpdate(name, instanceHealthStatus, proc, memory string) string {
 	return fmt.Sprintf(`
 	data "ibm_pi_image" "power_image" {
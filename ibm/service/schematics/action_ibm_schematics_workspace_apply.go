@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+var (
+	_ action.Action                   = &schematicsWorkspaceApplyAction{}
+	_ action.ActionWithConfigure      = &schematicsWorkspaceApplyAction{}
+	_ action.ActionWithValidateConfig = &schematicsWorkspaceApplyAction{}
+)
+
+func NewSchematicsWorkspaceApplyAction() action.Action {
+	return &schematicsWorkspaceApplyAction{}
+}
+
+type schematicsWorkspaceApplyAction struct {
+	session conns.ClientSession
+	client  *schematicsv1.SchematicsV1
+}
+
+func (a *schematicsWorkspaceApplyAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_schematics_workspace_apply"
+}
+
+func (a *schematicsWorkspaceApplyAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schematicsWorkspaceJobActionSchema("Runs a Terraform apply job in a Schematics workspace and waits for it to complete. The job log is streamed while the job runs, and the action fails when the job fails. Actions do not return output values.")
+}
+
+func (a *schematicsWorkspaceApplyAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	validateSchematicsWorkspaceJobAction(ctx, req, resp)
+}
+
+func (a *schematicsWorkspaceApplyAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session, a.client = configureSchematicsWorkspaceJobAction(req, resp)
+}
+
+func (a *schematicsWorkspaceApplyAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	invokeSchematicsWorkspaceJob(ctx, a.session, a.client, "apply", req, resp, func(workspaceID, refreshToken string) (*string, *core.DetailedResponse, error) {
+		applyOptions := &schematicsv1.ApplyWorkspaceCommandOptions{
+			WID:          core.StringPtr(workspaceID),
+			RefreshToken: core.StringPtr(refreshToken),
+		}
+		result, response, err := a.client.ApplyWorkspaceCommandWithContext(ctx, applyOptions)
+		if err != nil {
+			return nil, response, err
+		}
+		return result.Activityid, response, nil
+	})
+}
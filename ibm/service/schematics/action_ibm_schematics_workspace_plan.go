@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &schematicsWorkspacePlanAction{}
+	_ action.ActionWithConfigure      = &schematicsWorkspacePlanAction{}
+	_ action.ActionWithValidateConfig = &schematicsWorkspacePlanAction{}
+)
+
+const (
+	schematicsWorkspaceActivityCompleted  = "COMPLETED"
+	schematicsWorkspaceActivityFailed     = "FAILED"
+	schematicsWorkspaceActivityStopped    = "STOPPED"
+	schematicsWorkspaceActivityCancelled  = "CANCELLED"
+	schematicsWorkspaceActivityTerminated = "TERMINATED"
+)
+
+func NewSchematicsWorkspacePlanAction() action.Action {
+	return &schematicsWorkspacePlanAction{}
+}
+
+type schematicsWorkspacePlanAction struct {
+	session conns.ClientSession
+	client  *schematicsv1.SchematicsV1
+}
+
+type schematicsWorkspaceJobModel struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Timeout     types.String `tfsdk:"timeout"`
+	StreamLogs  types.Bool   `tfsdk:"stream_logs"`
+}
+
+func (a *schematicsWorkspacePlanAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_schematics_workspace_plan"
+}
+
+func (a *schematicsWorkspacePlanAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schematicsWorkspaceJobActionSchema("Runs a Terraform plan job in a Schematics workspace and waits for it to complete. The job log is streamed while the job runs, and the action fails when the job fails. Actions do not return output values.")
+}
+
+func (a *schematicsWorkspacePlanAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	validateSchematicsWorkspaceJobAction(ctx, req, resp)
+}
+
+func (a *schematicsWorkspacePlanAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session, a.client = configureSchematicsWorkspaceJobAction(req, resp)
+}
+
+func (a *schematicsWorkspacePlanAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	invokeSchematicsWorkspaceJob(ctx, a.session, a.client, "plan", req, resp, func(workspaceID, refreshToken string) (*string, *core.DetailedResponse, error) {
+		planOptions := &schematicsv1.PlanWorkspaceCommandOptions{
+			WID:          core.StringPtr(workspaceID),
+			RefreshToken: core.StringPtr(refreshToken),
+		}
+		result, response, err := a.client.PlanWorkspaceCommandWithContext(ctx, planOptions)
+		if err != nil {
+			return nil, response, err
+		}
+		return result.Activityid, response, nil
+	})
+}
+
+func schematicsWorkspaceJobActionSchema(description string) schema.Schema {
+	return schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Schematics workspace.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the job to complete, for example `30m` or `1h`. If not specified, defaults to `60m`.",
+			},
+			"stream_logs": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If false, only status changes of the job are reported instead of the job log. Default: true",
+			},
+		},
+	}
+}
+
+func validateSchematicsWorkspaceJobAction(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config schematicsWorkspaceJobModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Timeout.IsNull() || config.Timeout.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(config.Timeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout Format",
+			fmt.Sprintf("Failed to parse timeout '%s': %s. Expected format like '30m' or '1h'.", config.Timeout.ValueString(), err.Error()),
+		)
+	}
+}
+
+// configureSchematicsWorkspaceJobAction returns the client session and the Schematics client from the
+// provider data of a workspace job action.
+func configureSchematicsWorkspaceJobAction(req action.ConfigureRequest, resp *action.ConfigureResponse) (conns.ClientSession, *schematicsv1.SchematicsV1) {
+	if req.ProviderData == nil {
+		return nil, nil
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return nil, nil
+	}
+
+	client, err := session.SchematicsV1()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Schematics Client",
+			"An unexpected error occurred when creating the Schematics client.\n\n"+
+				"Schematics Client Error: "+err.Error(),
+		)
+		return nil, nil
+	}
+	return session, client
+}
+
+// invokeSchematicsWorkspaceJob starts a job in a workspace with start and waits for the job to complete.
+func invokeSchematicsWorkspaceJob(ctx context.Context, session conns.ClientSession, client *schematicsv1.SchematicsV1, command string, req action.InvokeRequest, resp *action.InvokeResponse, start func(workspaceID, refreshToken string) (*string, *core.DetailedResponse, error)) {
+	var config schematicsWorkspaceJobModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := config.WorkspaceID.ValueString()
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		if d, err := time.ParseDuration(config.Timeout.ValueString()); err == nil {
+			timeout = d
+		}
+	}
+	streamLogs := config.StreamLogs.IsNull() || config.StreamLogs.ValueBool()
+
+	// The workspace ID starts with the region of the workspace
+	region := strings.Split(workspaceID, ".")[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, session)
+	if updatedURL {
+		client.Service.Options.URL = schematicsURL
+	}
+
+	workspace, response, err := client.GetWorkspaceWithContext(ctx, &schematicsv1.GetWorkspaceOptions{WID: core.StringPtr(workspaceID)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Get Workspace",
+			fmt.Sprintf("Failed to get Schematics workspace '%s': %s\n%s", workspaceID, err.Error(), response),
+		)
+		return
+	}
+	if workspace.WorkspaceStatus != nil && workspace.WorkspaceStatus.Locked != nil && *workspace.WorkspaceStatus.Locked {
+		lockedBy := ""
+		if workspace.WorkspaceStatus.LockedBy != nil {
+			lockedBy = *workspace.WorkspaceStatus.LockedBy
+		}
+		resp.Diagnostics.AddError(
+			"Workspace Is Locked",
+			fmt.Sprintf("Schematics workspace '%s' is locked by '%s'. Wait for the running job to complete, or unlock the workspace.", workspaceID, lockedBy),
+		)
+		return
+	}
+	templateID := ""
+	if len(workspace.TemplateData) > 0 && workspace.TemplateData[0].ID != nil {
+		templateID = *workspace.TemplateData[0].ID
+	}
+
+	bmxSession, err := session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Get IAM Session",
+			"The IAM refresh token that Schematics requires to run jobs could not be retrieved.\n\n"+
+				"Session Error: "+err.Error(),
+		)
+		return
+	}
+
+	activityID, response, err := start(workspaceID, bmxSession.Config.IAMRefreshToken)
+	if err != nil || activityID == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Start Workspace %s Job", command),
+			fmt.Sprintf("Failed to start the %s job of Schematics workspace '%s': %v\n%s", command, workspaceID, err, response),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Workspace %s job '%s' started, waiting for it to complete (timeout: %v)...", command, *activityID, timeout),
+	})
+
+	if err := waitForSchematicsWorkspaceJob(ctx, client, workspaceID, templateID, *activityID, timeout, streamLogs, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Workspace %s Job Failed", command),
+			fmt.Sprintf("The %s job '%s' of Schematics workspace '%s' did not complete successfully: %s", command, *activityID, workspaceID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Workspace %s job '%s' completed successfully", command, *activityID),
+	})
+}
+
+// waitForSchematicsWorkspaceJob polls a workspace job until it completes, and sends the new part of the
+// job log on every poll when streamLogs is set.
+func waitForSchematicsWorkspaceJob(ctx context.Context, client *schematicsv1.SchematicsV1, workspaceID, templateID, activityID string, timeout time.Duration, streamLogs bool, sendProgress func(action.InvokeProgressEvent)) error {
+	deadline := time.Now().Add(timeout)
+	pollInterval := 10 * time.Second
+	lastStatus := ""
+	logOffset := 0
+
+	streamLog := func() {
+		if !streamLogs || templateID == "" {
+			return
+		}
+		logOptions := &schematicsv1.GetTemplateActivityLogOptions{
+			WID:        core.StringPtr(workspaceID),
+			TID:        core.StringPtr(templateID),
+			ActivityID: core.StringPtr(activityID),
+		}
+		// The log is not available until the job runs, so errors are retried on the next poll
+		jobLog, _, err := client.GetTemplateActivityLogWithContext(ctx, logOptions)
+		if err != nil || jobLog == nil {
+			return
+		}
+		if len(*jobLog) < logOffset {
+			logOffset = 0
+		}
+		if chunk := strings.TrimRight((*jobLog)[logOffset:], "\n"); chunk != "" {
+			sendProgress(action.InvokeProgressEvent{Message: chunk})
+		}
+		logOffset = len(*jobLog)
+	}
+
+	// wait returns an error when the context is cancelled before the poll interval elapses
+	wait := func() error {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-time.After(pollInterval):
+			return nil
+		}
+	}
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		default:
+		}
+
+		getOptions := &schematicsv1.GetWorkspaceActivityOptions{
+			WID:        core.StringPtr(workspaceID),
+			ActivityID: core.StringPtr(activityID),
+		}
+		activity, response, err := client.GetWorkspaceActivityWithContext(ctx, getOptions)
+		if err != nil {
+			if response == nil || response.StatusCode == 429 || response.StatusCode >= 500 {
+				if err := wait(); err != nil {
+					return err
+				}
+				continue
+			}
+			return fmt.Errorf("failed to get job status: %w", err)
+		}
+		if templateID == "" && len(activity.Templates) > 0 && activity.Templates[0].TemplateID != nil {
+			templateID = *activity.Templates[0].TemplateID
+		}
+
+		streamLog()
+
+		status := ""
+		if activity.Status != nil {
+			status = *activity.Status
+		}
+		if status != lastStatus {
+			if !streamLogs {
+				sendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Job status: %s", status),
+				})
+			}
+			lastStatus = status
+		}
+
+		switch status {
+		case schematicsWorkspaceActivityCompleted:
+			return nil
+		case schematicsWorkspaceActivityFailed, schematicsWorkspaceActivityStopped, schematicsWorkspaceActivityCancelled, schematicsWorkspaceActivityTerminated:
+			messages := append([]string{}, activity.Message...)
+			for _, template := range activity.Templates {
+				if template.Message != nil && *template.Message != "" {
+					messages = append(messages, *template.Message)
+				}
+			}
+			if len(messages) == 0 {
+				return fmt.Errorf("job status is %s", status)
+			}
+			return fmt.Errorf("job status is %s: %s", status, strings.Join(messages, "; "))
+		}
+
+		if err := wait(); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("timeout after %v waiting for job completion", timeout)
+}
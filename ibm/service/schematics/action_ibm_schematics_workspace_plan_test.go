@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+// TestAccIBMSchematicsWorkspacePlanActionBasic runs a plan and an apply job in the workspace
+// SCHEMATICS_WORKSPACE_ID.
+func TestAccIBMSchematicsWorkspacePlanActionBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceJobActionConfig("plan", acc.WorkspaceID, "30m"),
+			},
+			{
+				// The input of terraform_data changes with the command, so it is replaced and the
+				// apply action is triggered.
+				Config: testAccCheckIBMSchematicsWorkspaceJobActionConfig("apply", acc.WorkspaceID, "30m"),
+			},
+		},
+	})
+}
+
+func TestAccIBMSchematicsWorkspacePlanActionInvalidTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMSchematicsWorkspaceJobActionConfig("plan", acc.WorkspaceID, "thirty minutes"),
+				ExpectError: regexp.MustCompile("Invalid Timeout Format"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceJobActionConfig(command, workspaceID, timeout string) string {
+	return fmt.Sprintf(`
+		action "ibm_schematics_workspace_%[1]s" "job" {
+			config {
+				workspace_id = "%[2]s"
+				timeout      = "%[3]s"
+			}
+		}
+
+		resource "terraform_data" "job" {
+			input = "%[1]s-%[2]s"
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.ibm_schematics_workspace_%[1]s.job]
+				}
+			}
+		}
+	`, command, workspaceID, timeout)
+}
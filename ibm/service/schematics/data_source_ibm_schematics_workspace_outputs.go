@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &schematicsWorkspaceOutputsDataSource{}
+	_ datasource.DataSourceWithConfigure = &schematicsWorkspaceOutputsDataSource{}
+)
+
+// Workspace status while a job runs in the workspace.
+const schematicsWorkspaceStatusInProgress = "INPROGRESS"
+
+func NewSchematicsWorkspaceOutputsDataSource() datasource.DataSource {
+	return &schematicsWorkspaceOutputsDataSource{}
+}
+
+type schematicsWorkspaceOutputsDataSource struct {
+	session conns.ClientSession
+	client  *schematicsv1.SchematicsV1
+}
+
+type schematicsWorkspaceOutputsModel struct {
+	ID                   types.String  `tfsdk:"id"`
+	WorkspaceID          types.String  `tfsdk:"workspace_id"`
+	TemplateID           types.String  `tfsdk:"template_id"`
+	WorkspaceStatus      types.String  `tfsdk:"workspace_status"`
+	Outputs              types.Dynamic `tfsdk:"outputs"`
+	SensitiveOutputs     types.Dynamic `tfsdk:"sensitive_outputs"`
+	SensitiveOutputNames types.List    `tfsdk:"sensitive_output_names"`
+}
+
+func (d *schematicsWorkspaceOutputsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "ibm_schematics_workspace_outputs"
+}
+
+func (d *schematicsWorkspaceOutputsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the Terraform outputs of a Schematics workspace template with their types, like the terraform_remote_state data source. Reading fails while a job runs in the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The workspace ID and the template ID, separated by a slash.",
+			},
+			"workspace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Schematics workspace.",
+			},
+			"template_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the workspace template to read the outputs of. Required when the workspace has more than one template.",
+			},
+			"workspace_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the workspace.",
+			},
+			"outputs": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "The outputs of the template that are not sensitive as an object, with lists, maps, numbers and booleans kept as such.",
+			},
+			"sensitive_outputs": schema.DynamicAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The outputs of the template that are marked as sensitive, as an object.",
+			},
+			"sensitive_output_names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the outputs that are marked as sensitive in the template.",
+			},
+		},
+	}
+}
+
+func (d *schematicsWorkspaceOutputsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.SchematicsV1()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Schematics Client",
+			"An unexpected error occurred when creating the Schematics client.\n\n"+
+				"Schematics Client Error: "+err.Error(),
+		)
+		return
+	}
+	d.session = session
+	d.client = client
+}
+
+func (d *schematicsWorkspaceOutputsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config schematicsWorkspaceOutputsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := config.WorkspaceID.ValueString()
+
+	// The workspace ID starts with the region of the workspace
+	region := strings.Split(workspaceID, ".")[0]
+	schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, d.session)
+	if updatedURL {
+		d.client.Service.Options.URL = schematicsURL
+	}
+
+	workspace, response, err := d.client.GetWorkspaceWithContext(ctx, &schematicsv1.GetWorkspaceOptions{WID: core.StringPtr(workspaceID)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Get Workspace",
+			fmt.Sprintf("Failed to get Schematics workspace '%s': %s\n%s", workspaceID, err.Error(), response),
+		)
+		return
+	}
+	status := ""
+	if workspace.Status != nil {
+		status = *workspace.Status
+	}
+	locked := workspace.WorkspaceStatus != nil && workspace.WorkspaceStatus.Locked != nil && *workspace.WorkspaceStatus.Locked
+	if locked || strings.EqualFold(status, schematicsWorkspaceStatusInProgress) {
+		resp.Diagnostics.AddError(
+			"Workspace Job In Progress",
+			fmt.Sprintf("A job is running in Schematics workspace '%s' (status %s), its outputs may change. Read the outputs once the job is complete.", workspaceID, status),
+		)
+		return
+	}
+
+	templateIDs := make([]string, 0, len(workspace.TemplateData))
+	for _, template := range workspace.TemplateData {
+		if template.ID != nil {
+			templateIDs = append(templateIDs, *template.ID)
+		}
+	}
+	templateID := config.TemplateID.ValueString()
+	if templateID == "" {
+		if len(templateIDs) != 1 {
+			resp.Diagnostics.AddError(
+				"Template ID Required",
+				fmt.Sprintf("Schematics workspace '%s' has %d templates, set template_id to one of: %s.", workspaceID, len(templateIDs), strings.Join(templateIDs, ", ")),
+			)
+			return
+		}
+		templateID = templateIDs[0]
+	}
+
+	outputsOptions := &schematicsv1.GetWorkspaceOutputsOptions{WID: core.StringPtr(workspaceID)}
+	outputValuesList, response, err := d.client.GetWorkspaceOutputsWithContext(ctx, outputsOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Get Workspace Outputs",
+			fmt.Sprintf("Failed to get the outputs of Schematics workspace '%s': %s\n%s", workspaceID, err.Error(), response),
+		)
+		return
+	}
+
+	found := false
+	attrTypes := map[string]attr.Type{}
+	attrValues := map[string]attr.Value{}
+	sensitiveTypes := map[string]attr.Type{}
+	sensitiveValues := map[string]attr.Value{}
+	sensitive := make([]string, 0)
+	for _, template := range outputValuesList {
+		if template.ID == nil || *template.ID != templateID {
+			continue
+		}
+		found = true
+		for _, outputs := range template.OutputValues {
+			for name, output := range outputs {
+				fields, ok := output.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				value, err := schematicsOutputValue(fields["value"])
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Invalid Workspace Output",
+						fmt.Sprintf("Failed to convert output '%s' of Schematics workspace '%s': %s", name, workspaceID, err.Error()),
+					)
+					return
+				}
+				// Sensitive values are kept out of outputs, so they are not shown in the plan
+				if s, ok := fields["sensitive"].(bool); ok && s {
+					sensitive = append(sensitive, name)
+					sensitiveTypes[name] = value.Type(ctx)
+					sensitiveValues[name] = value
+					continue
+				}
+				attrTypes[name] = value.Type(ctx)
+				attrValues[name] = value
+			}
+		}
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Template Not Found",
+			fmt.Sprintf("Schematics workspace '%s' has no outputs for template '%s'. The templates of the workspace are: %s.", workspaceID, templateID, strings.Join(templateIDs, ", ")),
+		)
+		return
+	}
+
+	outputs, diags := types.ObjectValue(attrTypes, attrValues)
+	resp.Diagnostics.Append(diags...)
+	sensitiveOutputs, diags := types.ObjectValue(sensitiveTypes, sensitiveValues)
+	resp.Diagnostics.Append(diags...)
+	sort.Strings(sensitive)
+	sensitiveNames, diags := types.ListValueFrom(ctx, types.StringType, sensitive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%s/%s", workspaceID, templateID))
+	config.TemplateID = types.StringValue(templateID)
+	config.WorkspaceStatus = types.StringValue(status)
+	config.Outputs = types.DynamicValue(outputs)
+	config.SensitiveOutputs = types.DynamicValue(sensitiveOutputs)
+	config.SensitiveOutputNames = sensitiveNames
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// schematicsOutputValue converts a decoded JSON output value to a typed value. Objects and arrays
+// become object and tuple values, as with the jsondecode function.
+func schematicsOutputValue(v interface{}) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(f), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, e := range v {
+			elem, err := schematicsOutputValue(e)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%v", diags)
+		}
+		return tuple, nil
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrValues := make(map[string]attr.Value, len(v))
+		for k, e := range v {
+			value, err := schematicsOutputValue(e)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = value.Type(context.Background())
+			attrValues[k] = value
+		}
+		object, diags := types.ObjectValue(attrTypes, attrValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%v", diags)
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
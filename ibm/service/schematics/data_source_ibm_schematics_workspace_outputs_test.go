@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMSchematicsWorkspaceOutputsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceOutputsDataSourceConfig(acc.WorkspaceID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_workspace_outputs.test", "template_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_workspace_outputs.test", "workspace_status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSchematicsWorkspaceOutputsDataSourceTemplateNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMSchematicsWorkspaceOutputsDataSourceConfig(acc.WorkspaceID, "not-a-template"),
+				ExpectError: regexp.MustCompile("Template Not Found"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceOutputsDataSourceConfig(workspaceID, templateID string) string {
+	if templateID == "" {
+		return fmt.Sprintf(`
+		data "ibm_schematics_workspace_outputs" "test" {
+			workspace_id = "%s"
+		}
+	`, workspaceID)
+	}
+	return fmt.Sprintf(`
+		data "ibm_schematics_workspace_outputs" "test" {
+			workspace_id = "%s"
+			template_id  = "%s"
+		}
+	`, workspaceID, templateID)
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchematicsOutputValue(t *testing.T) {
+	cases := []struct {
+		name  string
+		value interface{}
+		want  attr.Value
+		err   bool
+	}{
+		{
+			name:  "null",
+			value: nil,
+			want:  types.StringNull(),
+		},
+		{
+			name:  "string",
+			value: "subnet-1",
+			want:  types.StringValue("subnet-1"),
+		},
+		{
+			name:  "bool",
+			value: true,
+			want:  types.BoolValue(true),
+		},
+		{
+			name:  "number",
+			value: float64(3),
+			want:  types.NumberValue(big.NewFloat(3)),
+		},
+		{
+			name:  "json number",
+			value: json.Number("1.5"),
+			want:  types.NumberValue(big.NewFloat(1.5)),
+		},
+		{
+			name:  "invalid json number",
+			value: json.Number("one"),
+			err:   true,
+		},
+		{
+			name:  "nested list",
+			value: []interface{}{"a", []interface{}{float64(1), false}},
+			want: types.TupleValueMust(
+				[]attr.Type{types.StringType, types.TupleType{ElemTypes: []attr.Type{types.NumberType, types.BoolType}}},
+				[]attr.Value{
+					types.StringValue("a"),
+					types.TupleValueMust(
+						[]attr.Type{types.NumberType, types.BoolType},
+						[]attr.Value{types.NumberValue(big.NewFloat(1)), types.BoolValue(false)},
+					),
+				},
+			),
+		},
+		{
+			name:  "empty list",
+			value: []interface{}{},
+			want:  types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+		},
+		{
+			name: "nested map",
+			value: map[string]interface{}{
+				"name":    "vpc",
+				"subnets": []interface{}{"subnet-1"},
+				"tags":    map[string]interface{}{"env": "prod", "owner": nil},
+			},
+			want: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name":    types.StringType,
+					"subnets": types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+					"tags":    types.ObjectType{AttrTypes: map[string]attr.Type{"env": types.StringType, "owner": types.StringType}},
+				},
+				map[string]attr.Value{
+					"name":    types.StringValue("vpc"),
+					"subnets": types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("subnet-1")}),
+					"tags": types.ObjectValueMust(
+						map[string]attr.Type{"env": types.StringType, "owner": types.StringType},
+						map[string]attr.Value{"env": types.StringValue("prod"), "owner": types.StringNull()},
+					),
+				},
+			),
+		},
+		{
+			name:  "unsupported type",
+			value: struct{}{},
+			err:   true,
+		},
+	}
+	for _, c := range cases {
+		got, err := schematicsOutputValue(c.value)
+		if c.err {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", c.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+			continue
+		}
+		if !got.Equal(c.want) {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
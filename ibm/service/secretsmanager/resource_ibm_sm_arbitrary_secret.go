@@ -41,11 +41,21 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 			"payload": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The arbitrary secret data payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				Description:  "The arbitrary secret data payload.",
+			},
+			"payload_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"payload_version"},
+				Description:  "The arbitrary secret data payload. The payload is not stored in the state.",
 			},
+			"payload_version": writeOnlyVersionSchema("payload_wo", "payload"),
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -293,7 +303,9 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting expiration_date"), ArbitrarySecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("payload", secret.Payload); err != nil {
+	if usesWriteOnlyPayload(d) {
+		d.Set("payload", nil)
+	} else if err = d.Set("payload", secret.Payload); err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
@@ -393,9 +405,12 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("payload") {
+	if d.HasChange("payload") || d.HasChange("payload_version") {
 		versionModel := &secretsmanagerv2.ArbitrarySecretVersionPrototype{}
 		versionModel.Payload = core.StringPtr(d.Get("payload").(string))
+		if payload, ok := getWriteOnlyString(d, "payload_wo"); ok {
+			versionModel.Payload = core.StringPtr(payload)
+		}
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -481,6 +496,9 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	if _, ok := d.GetOk("payload"); ok {
 		model.Payload = core.StringPtr(d.Get("payload").(string))
 	}
+	if payload, ok := getWriteOnlyString(d, "payload_wo"); ok {
+		model.Payload = core.StringPtr(payload)
+	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
 	}
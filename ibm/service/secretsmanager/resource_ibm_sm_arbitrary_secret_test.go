@@ -88,6 +88,42 @@ func TestAccIbmSmArbitrarySecretAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmArbitrarySecretWriteOnly(t *testing.T) {
+	resourceName := "ibm_sm_arbitrary_secret.sm_arbitrary_secret_basic"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: arbitrarySecretConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payload", payload),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+				),
+			},
+			{
+				// Migrating to the write-only payload creates a new version and clears the payload from the state.
+				Config: arbitrarySecretConfigWriteOnly(modifiedPayload, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payload", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "payload_wo"),
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+			{
+				Config: arbitrarySecretConfigWriteOnly(payload, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payload", ""),
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "3"),
+				),
+			},
+		},
+	})
+}
+
 var arbitrarySecretBasicConfigFormat = `
 		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_basic" {
 			instance_id   = "%s"
@@ -109,6 +145,15 @@ var arbitrarySecretFullConfigFormat = `
 			secret_group_id = "default"
 		}`
 
+var arbitrarySecretWriteOnlyConfigFormat = `
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_basic" {
+			instance_id   = "%s"
+  			region        = "%s"
+			name = "%s"
+  			payload_wo = "%s"
+  			payload_version = %d
+		}`
+
 func arbitrarySecretConfigBasic() string {
 	return fmt.Sprintf(arbitrarySecretBasicConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		arbitrarySecretName, payload)
@@ -119,6 +164,11 @@ func arbitrarySecretConfigAllArgs() string {
 		arbitrarySecretName, description, label, payload, expirationDate, customMetadata)
 }
 
+func arbitrarySecretConfigWriteOnly(payload string, payloadVersion int) string {
+	return fmt.Sprintf(arbitrarySecretWriteOnlyConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		arbitrarySecretName, payload, payloadVersion)
+}
+
 func testAccCheckIbmSmArbitrarySecretConfigUpdated() string {
 	return fmt.Sprintf(arbitrarySecretFullConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		modifiedArbitrarySecretName, modifiedDescription, modifiedLabel, modifiedPayload, modifiedExpirationDate, modifiedCustomMetadata)
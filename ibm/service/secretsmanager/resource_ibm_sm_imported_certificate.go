@@ -128,6 +128,34 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 				},
 				Description: "(Optional for non managed CSR secrets) The PEM-encoded private key to associate with the certificate.",
 			},
+			"certificate_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"certificate"},
+				RequiredWith:  []string{"payload_version"},
+				Description:   "The PEM-encoded contents of your certificate. The certificate is not stored in the state.",
+			},
+			"intermediate_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"intermediate"},
+				RequiredWith:  []string{"payload_version"},
+				Description:   "The PEM-encoded intermediate certificate to associate with the root certificate. The intermediate certificate is not stored in the state.",
+			},
+			"private_key_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"private_key"},
+				RequiredWith:  []string{"payload_version"},
+				Description:   "The PEM-encoded private key to associate with the certificate. The private key is not stored in the state.",
+			},
+			"payload_version": writeOnlyVersionSchema("certificate_wo", "certificate", "intermediate", "private_key"),
 			"managed_csr": &schema.Schema{
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -615,17 +643,23 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 				return tfErr.GetDiag()
 			}
 		}
-		if err = d.Set("certificate", secret.Certificate); err != nil {
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting certificate"), ImportedCertSecretResourceName, "read")
-			return tfErr.GetDiag()
-		}
-		if err = d.Set("intermediate", secret.Intermediate); err != nil {
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting intermediate"), ImportedCertSecretResourceName, "read")
-			return tfErr.GetDiag()
-		}
-		if err = d.Set("private_key", secret.PrivateKey); err != nil {
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting private_key"), ImportedCertSecretResourceName, "read")
-			return tfErr.GetDiag()
+		if usesWriteOnlyPayload(d) {
+			d.Set("certificate", nil)
+			d.Set("intermediate", nil)
+			d.Set("private_key", nil)
+		} else {
+			if err = d.Set("certificate", secret.Certificate); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting certificate"), ImportedCertSecretResourceName, "read")
+				return tfErr.GetDiag()
+			}
+			if err = d.Set("intermediate", secret.Intermediate); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting intermediate"), ImportedCertSecretResourceName, "read")
+				return tfErr.GetDiag()
+			}
+			if err = d.Set("private_key", secret.PrivateKey); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting private_key"), ImportedCertSecretResourceName, "read")
+				return tfErr.GetDiag()
+			}
 		}
 		if secret.Csr != nil {
 			if err = d.Set("csr", secret.Csr); err != nil {
@@ -805,7 +839,7 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 	}
 
 	// Apply change in secret data (if changed)
-	if d.HasChange("certificate") || d.HasChange("intermediate") || d.HasChange("private_key") {
+	if d.HasChange("certificate") || d.HasChange("intermediate") || d.HasChange("private_key") || d.HasChange("payload_version") {
 		versionModel := &secretsmanagerv2.ImportedCertificateVersionPrototype{}
 		versionModel.Certificate = core.StringPtr(d.Get("certificate").(string))
 		if _, ok := d.GetOk("intermediate"); ok {
@@ -814,6 +848,15 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 		if _, ok := d.GetOk("private_key"); ok {
 			versionModel.PrivateKey = core.StringPtr(formatCertificate(d.Get("private_key").(string)))
 		}
+		if certificate, ok := getWriteOnlyString(d, "certificate_wo"); ok {
+			versionModel.Certificate = core.StringPtr(formatCertificate(certificate))
+		}
+		if intermediate, ok := getWriteOnlyString(d, "intermediate_wo"); ok {
+			versionModel.Intermediate = core.StringPtr(formatCertificate(intermediate))
+		}
+		if privateKey, ok := getWriteOnlyString(d, "private_key_wo"); ok {
+			versionModel.PrivateKey = core.StringPtr(formatCertificate(privateKey))
+		}
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -940,6 +983,18 @@ func resourceIbmSmImportedCertificateMapToSecretPrototype(d *schema.ResourceData
 		model.PrivateKey = core.StringPtr(formatCertificate(d.Get("private_key").(string)))
 	}
 
+	if certificate, ok := getWriteOnlyString(d, "certificate_wo"); ok {
+		model.Certificate = core.StringPtr(formatCertificate(certificate))
+	}
+
+	if intermediate, ok := getWriteOnlyString(d, "intermediate_wo"); ok {
+		model.Intermediate = core.StringPtr(formatCertificate(intermediate))
+	}
+
+	if privateKey, ok := getWriteOnlyString(d, "private_key_wo"); ok {
+		model.PrivateKey = core.StringPtr(formatCertificate(privateKey))
+	}
+
 	if _, ok := d.GetOkExists("managed_csr"); ok {
 		managedCsrModel, err := mapManagedCsrOnCreate(d)
 		if err != nil {
@@ -196,6 +196,39 @@ func TestAccIbmSmImportedCertificateManagedCSR(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmImportedCertificateWriteOnly(t *testing.T) {
+	resourceName := "ibm_sm_imported_certificate.sm_imported_certificate"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmImportedCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: importedCertificateConfigWriteOnly(firstImportedCertData, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "certificate", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "certificate_wo"),
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_key_included", "false"),
+				),
+			},
+			{
+				// Changing payload_version creates a new version from the write-only arguments.
+				Config: importedCertificateConfigWriteOnly(secondImportedCertData, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "certificate", ""),
+					resource.TestCheckResourceAttr(resourceName, "private_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+					resource.TestCheckResourceAttr(resourceName, "private_key_included", "true"),
+					resource.TestCheckResourceAttr(resourceName, "intermediate_included", "true"),
+				),
+			},
+		},
+	})
+}
+
 var importedCertBasicConfigFormat = `
 		resource "ibm_sm_imported_certificate" "sm_imported_certificate_basic" {
 			instance_id   = "%s"
@@ -305,6 +338,18 @@ func importedCertificateConfigUpdated() string {
 		secondImportedCertData + `}`
 }
 
+// importedCertificateConfigWriteOnly sets the certificate data through the write-only arguments.
+func importedCertificateConfigWriteOnly(certData string, payloadVersion int) string {
+	writeOnlyData := strings.NewReplacer(
+		"\t\t\tcertificate = ", "\t\t\tcertificate_wo = ",
+		"\t\t\tintermediate = ", "\t\t\tintermediate_wo = ",
+		"\t\t\tprivate_key = ", "\t\t\tprivate_key_wo = ",
+	).Replace(certData)
+	return fmt.Sprintf(importedCertFullConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		importedCertName, description, label, customMetadata) +
+		writeOnlyData + fmt.Sprintf("\tpayload_version = %d\n\t\t}", payloadVersion)
+}
+
 func importedCertificateConfigManagedCSR() string {
 	return fmt.Sprintf(importedCertWithManagedCsrFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		importedCertName, description, label, customMetadata, managedCsrConfigFormat)
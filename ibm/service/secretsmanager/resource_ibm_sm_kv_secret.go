@@ -5,6 +5,7 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strings"
 )
@@ -55,12 +57,23 @@ func ResourceIbmSmKvSecret() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"data": &schema.Schema{
-				Type:        schema.TypeMap,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The payload data of a key-value secret.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"data", "data_wo"},
+				Description:  "The payload data of a key-value secret.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
 			},
+			"data_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"payload_version"},
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "The payload data of a key-value secret, as a JSON object. The payload is not stored in the state.",
+			},
+			"payload_version": writeOnlyVersionSchema("data_wo", "data"),
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -278,7 +291,9 @@ func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData,
 			return tfErr.GetDiag()
 		}
 	}
-	if secret.Data != nil {
+	if usesWriteOnlyPayload(d) {
+		d.Set("data", nil)
+	} else if secret.Data != nil {
 		d.Set("data", secret.Data)
 	}
 
@@ -360,9 +375,15 @@ func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData
 	}
 
 	// Apply change in secret data (if changed)
-	if d.HasChange("data") {
+	if d.HasChange("data") || d.HasChange("payload_version") {
 		versionModel := &secretsmanagerv2.KVSecretVersionPrototype{}
 		versionModel.Data = d.Get("data").(map[string]interface{})
+		if data, ok, err := getWriteOnlyKvData(d); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), KvSecretResourceName, "update")
+			return tfErr.GetDiag()
+		} else if ok {
+			versionModel.Data = data
+		}
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -462,6 +483,11 @@ func resourceIbmSmKvSecretMapToSecretPrototype(d *schema.ResourceData) (*secrets
 	if _, ok := d.GetOk("data"); ok {
 		model.Data = d.Get("data").(map[string]interface{})
 	}
+	if data, ok, err := getWriteOnlyKvData(d); err != nil {
+		return nil, err
+	} else if ok {
+		model.Data = data
+	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
 	}
@@ -470,3 +496,16 @@ func resourceIbmSmKvSecretMapToSecretPrototype(d *schema.ResourceData) (*secrets
 	}
 	return model, nil
 }
+
+// getWriteOnlyKvData decodes the JSON object set in the write-only data_wo argument.
+func getWriteOnlyKvData(d *schema.ResourceData) (map[string]interface{}, bool, error) {
+	raw, ok := getWriteOnlyString(d, "data_wo")
+	if !ok {
+		return nil, false, nil
+	}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return nil, false, fmt.Errorf("Error parsing data_wo: %s", err)
+	}
+	return data, true, nil
+}
@@ -91,6 +91,41 @@ func TestAccIbmSmKvSecretAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmKvSecretWriteOnly(t *testing.T) {
+	resourceName := "ibm_sm_kv_secret.sm_kv_secret_basic"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmKvSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: kvSecretConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.secret_key", "secret_value"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+				),
+			},
+			{
+				// Migrating to the write-only data creates a new version and clears the data from the state.
+				Config: kvSecretConfigWriteOnly(modifiedKvSecretData, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "data.secret_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "data_wo"),
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+			{
+				Config: kvSecretConfigWriteOnly(kvSecretData, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "3"),
+				),
+			},
+		},
+	})
+}
+
 var kvSecretBasicConfigFormat = `
 		resource "ibm_sm_kv_secret" "sm_kv_secret_basic" {
 			instance_id   = "%s"
@@ -111,11 +146,25 @@ var kvSecretFullConfigFormat = `
 			secret_group_id = "default"
 		}`
 
+var kvSecretWriteOnlyConfigFormat = `
+		resource "ibm_sm_kv_secret" "sm_kv_secret_basic" {
+			instance_id   = "%s"
+  			region        = "%s"
+			name = "%s"
+  			data_wo = jsonencode(%s)
+  			payload_version = %d
+		}`
+
 func kvSecretConfigBasic() string {
 	return fmt.Sprintf(kvSecretBasicConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		kvSecretName, kvSecretData)
 }
 
+func kvSecretConfigWriteOnly(data string, payloadVersion int) string {
+	return fmt.Sprintf(kvSecretWriteOnlyConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		kvSecretName, data, payloadVersion)
+}
+
 func kvSecretConfigAllArgs() string {
 	return fmt.Sprintf(kvSecretFullConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		kvSecretName, description, label, kvSecretData, customMetadata)
@@ -0,0 +1,698 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	homedir "github.com/mitchellh/go-homedir"
+	"gopkg.in/yaml.v3"
+)
+
+const SecretSyncResourceName = "ibm_sm_secret_sync"
+
+// syncSecret is a secret read from a sync source. Secrets with a payload are synced as arbitrary
+// secrets and secrets with data as key-value secrets.
+type syncSecret struct {
+	Type    string
+	Payload string
+	Data    map[string]interface{}
+}
+
+func ResourceIbmSmSecretSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretSyncCreate,
+		ReadContext:   resourceIbmSmSecretSyncRead,
+		UpdateContext: resourceIbmSmSecretSyncUpdate,
+		DeleteContext: resourceIbmSmSecretSyncDelete,
+		CustomizeDiff: resourceIbmSmSecretSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret group that receives the secrets, or `default`.",
+			},
+			"name_prefix": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A prefix that is added to the name of every synced secret.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Labels that are assigned to every synced secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_secrets_on_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the synced secrets when the resource is destroyed, and the synced secrets that are removed from the source on apply.",
+			},
+			"source": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The store that the secrets are read from.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file": &schema.Schema{
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"source.0.file", "source.0.secrets_manager", "source.0.vault"},
+							Description:  "A local JSON or YAML file that maps secret names to a string payload or to key-value data.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The path of the file.",
+									},
+									"format": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"json", "yaml"}, false),
+										Description:  "The format of the file, `json` or `yaml`. By default, the format is taken from the file extension.",
+									},
+								},
+							},
+						},
+						"secrets_manager": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The arbitrary and key-value secrets of a secret group of another Secrets Manager instance.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of the source Secrets Manager instance.",
+									},
+									"region": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The region of the source Secrets Manager instance.",
+									},
+									"endpoint_type": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+										Description:  "public or private.",
+									},
+									"secret_group_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of the source secret group, or `default`.",
+									},
+								},
+							},
+						},
+						"vault": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "A Vault-compatible KV version 2 secrets engine.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The address of the Vault server, for example `https://vault.example.com:8200`.",
+									},
+									"token": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Sensitive:   true,
+										Description: "The token used to read the secrets.",
+									},
+									"namespace": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The Vault namespace.",
+									},
+									"mount": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "secret",
+										Description: "The mount path of the KV version 2 secrets engine.",
+									},
+									"path": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The path under the mount that is read recursively.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"secrets": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The synced secrets by name. Each value is the secret type and the SHA-256 hash of the secret data, so the plan shows the secrets that are created or updated without their values.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"secret_ids": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The IDs of the synced secrets by name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceIbmSmSecretSyncCustomizeDiff reads the source during the plan, so the plan lists the
+// secrets that the apply creates or updates.
+func resourceIbmSmSecretSyncCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("labels") {
+		return diff.SetNewComputed("secrets")
+	}
+	source, err := readSyncSource(context, diff, meta)
+	if err != nil {
+		return err
+	}
+	hashes := syncSecretHashes(source)
+
+	old := diff.Get("secrets").(map[string]interface{})
+	changed := len(old) != len(hashes)
+	newSecrets := false
+	for name, hash := range hashes {
+		if old[name] != hash {
+			changed = true
+		}
+		if _, ok := old[name]; !ok {
+			newSecrets = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := diff.SetNew("secrets", hashes); err != nil {
+		return err
+	}
+	if newSecrets {
+		return diff.SetNewComputed("secret_ids")
+	}
+	return nil
+}
+
+func resourceIbmSmSecretSyncCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, instanceId, _, err := secretSyncTarget(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretSyncResourceName, "create")
+		return tfErr.GetDiag()
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, d.Get("secret_group_id").(string)))
+
+	if diags := syncSecrets(context, d, meta, "create"); diags != nil {
+		return diags
+	}
+	return resourceIbmSmSecretSyncRead(context, d, meta)
+}
+
+func resourceIbmSmSecretSyncRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 3 {
+		tfErr := flex.TerraformErrorf(nil, "Wrong format of resource ID. The format is `<region>/<instance_id>/<secret_group_id>`", SecretSyncResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	d.Set("region", id[0])
+	d.Set("instance_id", id[1])
+	d.Set("secret_group_id", id[2])
+
+	_, _, secretsManagerClient, err := secretSyncTarget(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretSyncResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	existing, err := listSyncGroupSecrets(context, secretsManagerClient, id[2])
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretsWithContext failed %s", err), SecretSyncResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	// Secrets that were deleted from the target group are dropped, so the next plan creates them again.
+	secrets := map[string]interface{}{}
+	secretIds := map[string]interface{}{}
+	for name, hash := range d.Get("secrets").(map[string]interface{}) {
+		if secretId, ok := existing[d.Get("name_prefix").(string)+name]; ok {
+			secrets[name] = hash
+			secretIds[name] = secretId
+		}
+	}
+	if err = d.Set("secrets", secrets); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting secrets", SecretSyncResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_ids", secretIds); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting secret_ids", SecretSyncResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func resourceIbmSmSecretSyncUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := syncSecrets(context, d, meta, "update"); diags != nil {
+		return diags
+	}
+	return resourceIbmSmSecretSyncRead(context, d, meta)
+}
+
+func resourceIbmSmSecretSyncDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("delete_secrets_on_destroy").(bool) {
+		d.SetId("")
+		return nil
+	}
+	_, _, secretsManagerClient, err := secretSyncTarget(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretSyncResourceName, "delete")
+		return tfErr.GetDiag()
+	}
+	for _, secretId := range d.Get("secret_ids").(map[string]interface{}) {
+		if err := deleteSyncSecret(context, secretsManagerClient, secretId.(string)); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), SecretSyncResourceName, "delete")
+			return tfErr.GetDiag()
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func deleteSyncSecret(context context.Context, client *secretsmanagerv2.SecretsManagerV2, secretId string) error {
+	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
+	deleteSecretOptions.SetID(secretId)
+	response, err := client.DeleteSecretWithContext(context, deleteSecretOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// syncSecrets creates the secrets that are missing from the target group and adds a new version
+// to the secrets whose data changed since the last apply. With delete_secrets_on_destroy, the
+// secrets that were removed from the source are deleted.
+func syncSecrets(context context.Context, d *schema.ResourceData, meta interface{}, operation string) diag.Diagnostics {
+	_, _, secretsManagerClient, err := secretSyncTarget(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretSyncResourceName, operation)
+		return tfErr.GetDiag()
+	}
+	source, err := readSyncSource(context, d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretSyncResourceName, operation)
+		return tfErr.GetDiag()
+	}
+	hashes := syncSecretHashes(source)
+	// The source is read again during the apply, only write the secrets that the plan showed
+	if plan := d.GetRawPlan(); !plan.IsNull() && plan.GetAttr("secrets").IsKnown() {
+		if err := checkSyncSecretHashes(d.Get("secrets").(map[string]interface{}), hashes); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), SecretSyncResourceName, operation)
+			return tfErr.GetDiag()
+		}
+	}
+	groupId := d.Get("secret_group_id").(string)
+	existing, err := listSyncGroupSecrets(context, secretsManagerClient, groupId)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretsWithContext failed %s", err), SecretSyncResourceName, operation)
+		return tfErr.GetDiag()
+	}
+
+	oldSecrets, _ := d.GetChange("secrets")
+	old := oldSecrets.(map[string]interface{})
+	labels := flex.ExpandStringList(d.Get("labels").([]interface{}))
+	secretIds := map[string]interface{}{}
+
+	names := make([]string, 0, len(source))
+	for name := range source {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		secret := source[name]
+		targetName := d.Get("name_prefix").(string) + name
+		secretId, exists := existing[targetName]
+		switch {
+		case !exists:
+			secretId, err = createSyncSecret(context, secretsManagerClient, targetName, groupId, labels, secret)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretWithContext failed for %s: %s", targetName, err), SecretSyncResourceName, operation)
+				return tfErr.GetDiag()
+			}
+			log.Printf("[INFO] Created %s secret %s", secret.Type, targetName)
+		case old[name] != hashes[name]:
+			if err = updateSyncSecret(context, secretsManagerClient, secretId, labels, secret); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error updating secret %s: %s", targetName, err), SecretSyncResourceName, operation)
+				return tfErr.GetDiag()
+			}
+			log.Printf("[INFO] Updated %s secret %s", secret.Type, targetName)
+		case d.HasChange("labels"):
+			if err = updateSyncSecretLabels(context, secretsManagerClient, secretId, labels); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateSecretMetadataWithContext failed for %s: %s", targetName, err), SecretSyncResourceName, operation)
+				return tfErr.GetDiag()
+			}
+		}
+		secretIds[name] = secretId
+	}
+
+	// Secrets that were removed from the source are deleted together with the resource, so they
+	// are deleted now instead of being left behind
+	if d.Get("delete_secrets_on_destroy").(bool) {
+		oldSecretIds, _ := d.GetChange("secret_ids")
+		for name, secretId := range oldSecretIds.(map[string]interface{}) {
+			if _, ok := source[name]; ok {
+				continue
+			}
+			if err := deleteSyncSecret(context, secretsManagerClient, secretId.(string)); err != nil {
+				tfErr := flex.TerraformErrorf(err, err.Error(), SecretSyncResourceName, operation)
+				return tfErr.GetDiag()
+			}
+			log.Printf("[INFO] Deleted secret %s that was removed from the source", d.Get("name_prefix").(string)+name)
+		}
+	}
+
+	d.Set("secrets", hashes)
+	d.Set("secret_ids", secretIds)
+	return nil
+}
+
+func createSyncSecret(context context.Context, client *secretsmanagerv2.SecretsManagerV2, name, groupId string, labels []string, secret syncSecret) (string, error) {
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+	if secret.Type == KvSecretType {
+		createSecretOptions.SetSecretPrototype(&secretsmanagerv2.KVSecretPrototype{
+			SecretType:    core.StringPtr(KvSecretType),
+			Name:          &name,
+			SecretGroupID: &groupId,
+			Labels:        labels,
+			Data:          secret.Data,
+		})
+	} else {
+		createSecretOptions.SetSecretPrototype(&secretsmanagerv2.ArbitrarySecretPrototype{
+			SecretType:    core.StringPtr(ArbitrarySecretType),
+			Name:          &name,
+			SecretGroupID: &groupId,
+			Labels:        labels,
+			Payload:       &secret.Payload,
+		})
+	}
+	secretIntf, response, err := client.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		return "", fmt.Errorf("%s\n%s", err, response)
+	}
+	switch s := secretIntf.(type) {
+	case *secretsmanagerv2.KVSecret:
+		return *s.ID, nil
+	case *secretsmanagerv2.ArbitrarySecret:
+		return *s.ID, nil
+	}
+	return "", fmt.Errorf("unexpected secret type in response %T", secretIntf)
+}
+
+func updateSyncSecret(context context.Context, client *secretsmanagerv2.SecretsManagerV2, secretId string, labels []string, secret syncSecret) error {
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretId)
+	if secret.Type == KvSecretType {
+		createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.KVSecretVersionPrototype{Data: secret.Data})
+	} else {
+		createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.ArbitrarySecretVersionPrototype{Payload: &secret.Payload})
+	}
+	_, response, err := client.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		return fmt.Errorf("%s\n%s", err, response)
+	}
+	return updateSyncSecretLabels(context, client, secretId, labels)
+}
+
+func updateSyncSecretLabels(context context.Context, client *secretsmanagerv2.SecretsManagerV2, secretId string, labels []string) error {
+	if labels == nil {
+		labels = []string{}
+	}
+	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
+	updateSecretMetadataOptions.SetID(secretId)
+	updateSecretMetadataOptions.SecretMetadataPatch = map[string]interface{}{"labels": labels}
+	_, response, err := client.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
+	if err != nil {
+		return fmt.Errorf("%s\n%s", err, response)
+	}
+	return nil
+}
+
+// secretSyncTarget returns the region, the instance ID and a client of the target instance.
+func secretSyncTarget(d *schema.ResourceData, meta interface{}) (string, string, *secretsmanagerv2.SecretsManagerV2, error) {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		return "", "", nil, err
+	}
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	client := getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	return region, instanceId, client, nil
+}
+
+// listSyncGroupSecrets returns the IDs of the arbitrary and key-value secrets of a group by name.
+func listSyncGroupSecrets(context context.Context, client *secretsmanagerv2.SecretsManagerV2, groupId string) (map[string]string, error) {
+	secrets := map[string]string{}
+	err := forEachSyncGroupSecret(context, client, groupId, func(id, name, secretType string) error {
+		secrets[name] = id
+		return nil
+	})
+	return secrets, err
+}
+
+func forEachSyncGroupSecret(context context.Context, client *secretsmanagerv2.SecretsManagerV2, groupId string, f func(id, name, secretType string) error) error {
+	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{
+		Groups:      []string{groupId},
+		SecretTypes: []string{ArbitrarySecretType, KvSecretType},
+		Limit:       core.Int64Ptr(200),
+		Offset:      core.Int64Ptr(0),
+	}
+	for {
+		collection, response, err := client.ListSecretsWithContext(context, listSecretsOptions)
+		if err != nil {
+			return fmt.Errorf("%s\n%s", err, response)
+		}
+		for _, secretIntf := range collection.Secrets {
+			switch s := secretIntf.(type) {
+			case *secretsmanagerv2.ArbitrarySecretMetadata:
+				err = f(*s.ID, *s.Name, ArbitrarySecretType)
+			case *secretsmanagerv2.KVSecretMetadata:
+				err = f(*s.ID, *s.Name, KvSecretType)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if collection.Next == nil || len(collection.Secrets) == 0 {
+			return nil
+		}
+		listSecretsOptions.Offset = core.Int64Ptr(*listSecretsOptions.Offset + int64(len(collection.Secrets)))
+	}
+}
+
+// syncSecretHashes returns the type and the SHA-256 hash of the data of every secret.
+func syncSecretHashes(source map[string]syncSecret) map[string]interface{} {
+	hashes := make(map[string]interface{}, len(source))
+	for name, secret := range source {
+		var data []byte
+		if secret.Type == KvSecretType {
+			data, _ = json.Marshal(secret.Data)
+		} else {
+			data = []byte(secret.Payload)
+		}
+		sum := sha256.Sum256(data)
+		hashes[name] = secret.Type + ":" + hex.EncodeToString(sum[:])
+	}
+	return hashes
+}
+
+// checkSyncSecretHashes returns an error that lists the secrets whose hash read during the apply
+// differs from the hash in the plan.
+func checkSyncSecretHashes(planned, hashes map[string]interface{}) error {
+	changed := make([]string, 0)
+	for name, hash := range hashes {
+		if planned[name] != hash {
+			changed = append(changed, name)
+		}
+	}
+	for name := range planned {
+		if _, ok := hashes[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	sort.Strings(changed)
+	return fmt.Errorf("The secrets %s changed in the source after the plan was created. Run terraform plan again", strings.Join(changed, ", "))
+}
+
+// readSyncSource reads the secrets of the configured source.
+func readSyncSource(context context.Context, d interface{ Get(string) interface{} }, meta interface{}) (map[string]syncSecret, error) {
+	if len(d.Get("source.0.file").([]interface{})) > 0 {
+		return readSyncFile(d.Get("source.0.file.0.path").(string), d.Get("source.0.file.0.format").(string))
+	}
+	if len(d.Get("source.0.secrets_manager").([]interface{})) > 0 {
+		client, err := GetInstanceClient(meta.(conns.ClientSession), d.Get("source.0.secrets_manager.0.instance_id").(string),
+			d.Get("source.0.secrets_manager.0.region").(string), d.Get("source.0.secrets_manager.0.endpoint_type").(string))
+		if err != nil {
+			return nil, err
+		}
+		return readSyncSecretsManager(context, client, d.Get("source.0.secrets_manager.0.secret_group_id").(string))
+	}
+	return readSyncVault(context, d.Get("source.0.vault.0.address").(string), d.Get("source.0.vault.0.token").(string),
+		d.Get("source.0.vault.0.namespace").(string), d.Get("source.0.vault.0.mount").(string), d.Get("source.0.vault.0.path").(string))
+}
+
+func readSyncFile(path, format string) (map[string]syncSecret, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading secrets file %s: %s", path, err)
+	}
+	if format == "" {
+		format = "json"
+		if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+			format = "yaml"
+		}
+	}
+	values := map[string]interface{}{}
+	if format == "yaml" {
+		err = yaml.Unmarshal(content, &values)
+	} else {
+		err = json.Unmarshal(content, &values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing secrets file %s: %s", path, err)
+	}
+
+	secrets := make(map[string]syncSecret, len(values))
+	for name, value := range values {
+		switch v := value.(type) {
+		case string:
+			secrets[name] = syncSecret{Type: ArbitrarySecretType, Payload: v}
+		case map[string]interface{}:
+			secrets[name] = syncSecret{Type: KvSecretType, Data: v}
+		default:
+			return nil, fmt.Errorf("Secret %s of %s must be a string or an object, got %T", name, path, value)
+		}
+	}
+	return secrets, nil
+}
+
+func readSyncSecretsManager(context context.Context, client *secretsmanagerv2.SecretsManagerV2, groupId string) (map[string]syncSecret, error) {
+	secrets := map[string]syncSecret{}
+	err := forEachSyncGroupSecret(context, client, groupId, func(id, name, secretType string) error {
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+		getSecretOptions.SetID(id)
+		secretIntf, response, err := client.GetSecretWithContext(context, getSecretOptions)
+		if err != nil {
+			return fmt.Errorf("Error reading source secret %s: %s\n%s", name, err, response)
+		}
+		switch s := secretIntf.(type) {
+		case *secretsmanagerv2.ArbitrarySecret:
+			secrets[name] = syncSecret{Type: ArbitrarySecretType, Payload: *s.Payload}
+		case *secretsmanagerv2.KVSecret:
+			secrets[name] = syncSecret{Type: KvSecretType, Data: s.Data}
+		}
+		return nil
+	})
+	return secrets, err
+}
+
+// readSyncVault reads every secret under a path of a KV version 2 secrets engine. Nested paths
+// are synced with `/` replaced by `-` in the secret name.
+func readSyncVault(context context.Context, address, token, namespace, mount, path string) (map[string]syncSecret, error) {
+	client := &http.Client{Timeout: 60 * time.Second}
+	base := strings.TrimSuffix(address, "/") + "/v1/" + strings.Trim(mount, "/")
+	vaultRequest := func(method, url string, result interface{}) error {
+		request, err := http.NewRequestWithContext(context, method, url, nil)
+		if err != nil {
+			return err
+		}
+		request.Header.Set("X-Vault-Token", token)
+		if namespace != "" {
+			request.Header.Set("X-Vault-Namespace", namespace)
+		}
+		response, err := client.Do(request)
+		if err != nil {
+			return fmt.Errorf("Error calling Vault %s: %s", url, err)
+		}
+		defer response.Body.Close()
+		body, _ := io.ReadAll(response.Body)
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("Vault returned status %d for %s: %s", response.StatusCode, url, string(body))
+		}
+		return json.Unmarshal(body, result)
+	}
+
+	secrets := map[string]syncSecret{}
+	prefix := strings.Trim(path, "/")
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		var list struct {
+			Data struct {
+				Keys []string `json:"keys"`
+			} `json:"data"`
+		}
+		if err := vaultRequest("LIST", base+"/metadata/"+dir, &list); err != nil {
+			return err
+		}
+		for _, key := range list.Data.Keys {
+			if strings.HasSuffix(key, "/") {
+				if err := walk(dir + key); err != nil {
+					return err
+				}
+				continue
+			}
+			var secret struct {
+				Data struct {
+					Data map[string]interface{} `json:"data"`
+				} `json:"data"`
+			}
+			if err := vaultRequest(http.MethodGet, base+"/data/"+dir+key, &secret); err != nil {
+				return err
+			}
+			name := strings.TrimPrefix(strings.TrimPrefix(dir+key, prefix), "/")
+			secrets[strings.ReplaceAll(name, "/", "-")] = syncSecret{Type: KvSecretType, Data: secret.Data.Data}
+		}
+		return nil
+	}
+	dir := ""
+	if prefix != "" {
+		dir = prefix + "/"
+	}
+	if err := walk(dir); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
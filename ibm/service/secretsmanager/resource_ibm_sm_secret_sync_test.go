@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+var secretSyncPrefix = "terraform-test-sync-"
+
+func TestAccIbmSmSecretSyncFile(t *testing.T) {
+	resourceName := "ibm_sm_secret_sync.sm_secret_sync"
+	sourceFile := filepath.Join(t.TempDir(), "secrets.json")
+	writeSecretSyncFile(t, sourceFile, `{"arbitrary-secret": "payload", "kv-secret": {"key": "value"}}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmSecretSyncDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: secretSyncConfigFile(sourceFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_ids.arbitrary-secret"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_ids.kv-secret"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() {
+					writeSecretSyncFile(t, sourceFile, `{"arbitrary-secret": "modified payload", "kv-secret": {"key": "value"}, "another-secret": "payload"}`)
+				},
+				Config: secretSyncConfigFile(sourceFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_ids.another-secret"),
+				),
+			},
+		},
+	})
+}
+
+func writeSecretSyncFile(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func secretSyncConfigFile(sourceFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_secret_sync" "sm_secret_sync" {
+			instance_id               = "%s"
+			region                    = "%s"
+			secret_group_id           = "default"
+			name_prefix               = "%s"
+			labels                    = ["%s"]
+			delete_secrets_on_destroy = true
+			source {
+				file {
+					path = "%s"
+				}
+			}
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, secretSyncPrefix, label, sourceFile)
+}
+
+func testAccCheckIbmSmSecretSyncDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+
+	secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_secret_sync" {
+			continue
+		}
+
+		for name, secretId := range rs.Primary.Attributes {
+			if !strings.HasPrefix(name, "secret_ids.") || name == "secret_ids.%" {
+				continue
+			}
+			getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+			getSecretOptions.SetID(secretId)
+
+			_, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+			if err == nil {
+				return fmt.Errorf("Synced secret still exists: %s", secretId)
+			} else if response.StatusCode != 404 {
+				return fmt.Errorf("Error checking for synced secret (%s) has been destroyed: %s", secretId, err)
+			}
+		}
+	}
+
+	return nil
+}
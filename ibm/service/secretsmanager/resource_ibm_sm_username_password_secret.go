@@ -129,6 +129,16 @@ func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
 				Sensitive:   true,
 				Description: "The password that is assigned to the secret.",
 			},
+			"password_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"payload_version"},
+				Description:   "The password that is assigned to the secret. The password is not stored in the state.",
+			},
+			"payload_version": writeOnlyVersionSchema("password_wo", "password"),
 			"password_generation_policy": &schema.Schema{
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -382,7 +392,9 @@ func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting username"), UsernamePasswordSecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("password", secret.Password); err != nil {
+	if usesWriteOnlyPayload(d) {
+		d.Set("password", "")
+	} else if err = d.Set("password", secret.Password); err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting password"), UsernamePasswordSecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
@@ -512,9 +524,12 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("password") {
+	if d.HasChange("password") || d.HasChange("payload_version") {
 		versionModel := &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{}
 		versionModel.Password = core.StringPtr(d.Get("password").(string))
+		if password, ok := getWriteOnlyString(d, "password_wo"); ok {
+			versionModel.Password = core.StringPtr(password)
+		}
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -632,6 +647,9 @@ func resourceIbmSmUsernamePasswordSecretMapToSecretPrototype(d *schema.ResourceD
 	if _, ok := d.GetOk("password"); ok {
 		model.Password = core.StringPtr(d.Get("password").(string))
 	}
+	if password, ok := getWriteOnlyString(d, "password_wo"); ok {
+		model.Password = core.StringPtr(password)
+	}
 	if _, ok := d.GetOk("rotation"); ok {
 		RotationModel, err := resourceIbmSmUsernamePasswordSecretMapToRotationPolicy(d.Get("rotation").([]interface{})[0].(map[string]interface{}))
 		if err != nil {
@@ -110,6 +110,42 @@ func TestAccIbmSmUsernamePasswordSecretAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmUsernamePasswordSecretWriteOnly(t *testing.T) {
+	resourceName := "ibm_sm_username_password_secret.sm_username_password_secret_basic"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmUsernamePasswordSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: usernamePasswordSecretConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password", password),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+				),
+			},
+			{
+				// Migrating to the write-only password creates a new version and clears the password from the state.
+				Config: usernamePasswordSecretConfigWriteOnly(modifiedPassword, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+			{
+				Config: usernamePasswordSecretConfigWriteOnly(password, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password", ""),
+					resource.TestCheckResourceAttr(resourceName, "payload_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "3"),
+				),
+			},
+		},
+	})
+}
+
 var usernamePasswordSecretBasicConfigFormat = `
 		resource "ibm_sm_username_password_secret" "sm_username_password_secret_basic" {
 			instance_id   = "%s"
@@ -135,11 +171,26 @@ var usernamePasswordSecretFullConfigFormat = `
 			password_generation_policy %s
 		}`
 
+var usernamePasswordSecretWriteOnlyConfigFormat = `
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_basic" {
+			instance_id   = "%s"
+  			region        = "%s"
+			name = "%s"
+			username = "%s"
+			password_wo = "%s"
+			payload_version = %d
+		}`
+
 func usernamePasswordSecretConfigBasic() string {
 	return fmt.Sprintf(usernamePasswordSecretBasicConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		usernamePasswordSecretName, username, password)
 }
 
+func usernamePasswordSecretConfigWriteOnly(password string, payloadVersion int) string {
+	return fmt.Sprintf(usernamePasswordSecretWriteOnlyConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		usernamePasswordSecretName, username, password, payloadVersion)
+}
+
 func usernamePasswordSecretConfigAllArgs() string {
 	return fmt.Sprintf(usernamePasswordSecretFullConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		usernamePasswordSecretName, description, label, username, password, expirationDate, customMetadata, rotationPolicy,
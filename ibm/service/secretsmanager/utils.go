@@ -9,8 +9,10 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"os"
 	"strconv"
@@ -63,27 +65,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getDefaultRegion(originalClient)
 	}
 }
 
+// extract region from base URL (provider config)
+func getDefaultRegion(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getDefaultEndpointType(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// extract the endpoint type from base URL (provider config)
+func getDefaultEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -132,6 +143,55 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	return newClient
 }
 
+// writeOnlyVersionSchema returns the schema of the payload_version argument that triggers writing
+// the write-only arguments of a secret. Write-only arguments are never stored in the state, so a
+// new secret version is only created when payload_version changes. It requires the write-only
+// argument that holds the secret data, so that a new version never re-sends the data of the
+// state, and the arguments that store the secret data in the state conflict with it.
+func writeOnlyVersionSchema(requiredWith string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeInt,
+		Optional:      true,
+		ValidateFunc:  validation.IntAtLeast(1),
+		RequiredWith:  []string{requiredWith},
+		ConflictsWith: conflictsWith,
+		Description:   "A version number for the write-only arguments. Change it to create a new secret version from the write-only arguments.",
+	}
+}
+
+// getWriteOnlyString returns the value of a write-only argument. Write-only arguments are only
+// available in the configuration.
+func getWriteOnlyString(d *schema.ResourceData, key string) (string, bool) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() || value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", false
+	}
+	return value.AsString(), true
+}
+
+// usesWriteOnlyPayload reports whether the secret data is written through write-only arguments, in
+// which case it must not be read into the state.
+func usesWriteOnlyPayload(d *schema.ResourceData) bool {
+	return d.Get("payload_version").(int) > 0
+}
+
+// GetInstanceClient returns a Secrets Manager client for the given instance, for resources of
+// other services that store credentials in Secrets Manager. The region and endpoint type default
+// to the ones of the provider configuration when they are empty.
+func GetInstanceClient(clientSession conns.ClientSession, instanceId string, region string, endpointType string) (*secretsmanagerv2.SecretsManagerV2, error) {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(clientSession)
+	if err != nil {
+		return nil, err
+	}
+	if region == "" {
+		region = getDefaultRegion(secretsManagerClient)
+	}
+	if endpointType == "" {
+		endpointType = getDefaultEndpointType(secretsManagerClient)
+	}
+	return getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, endpointType, endpointsFile), nil
+}
+
 // Add the fields needed for building the instance endpoint to the given schema
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	resource.Schema["instance_id"] = &schema.Schema{
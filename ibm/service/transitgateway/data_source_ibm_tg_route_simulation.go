@@ -0,0 +1,601 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tgSimulationConnections            = "connections"
+	tgSimulationPrefixes               = "prefixes"
+	tgSimulationReplacePrefixFilters   = "replace_prefix_filters"
+	tgSimulationUseRouteReport         = "use_route_report"
+	tgSimulationRouteReportId          = "route_report_id"
+	tgSimulationSimulatedConnections   = "simulated_connections"
+	tgSimulationPlanned                = "planned"
+	tgSimulationLearnedRoutes          = "learned_routes"
+	tgSimulationSourceConnectionId     = "source_connection_id"
+	tgSimulationSourceConnectionName   = "source_connection_name"
+	tgSimulationPermitted              = "permitted"
+	tgSimulationFilterIndex            = "filter_index"
+	tgSimulationOverlappingPrefixes    = "overlapping_prefixes"
+	tgSimulationOverlappingPrefix      = "overlapping_prefix"
+	tgSimulationOverlappingConnection  = "overlapping_connection_id"
+	tgSimulationOverlappingNetworkType = "overlapping_network_type"
+	tgSimulationDefaultFilterAction    = "permit"
+)
+
+func DataSourceIBMTransitGatewayRouteSimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMTransitGatewayRouteSimulationRead,
+		Schema: map[string]*schema.Schema{
+			tgGatewayId: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{tgGatewayId, tgSimulationConnections},
+				Description:  "The Transit Gateway identifier. The connections and prefix filters of the gateway are included in the simulation",
+			},
+			tgSimulationUseRouteReport: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the prefixes of the connections of the gateway are taken from the most recent complete route report",
+			},
+			tgSimulationConnections: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Planned connections, or changes to the connections of the gateway",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ID: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The identifier of an existing connection of the gateway. Leave empty for a planned connection",
+						},
+						tgConnName: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the connection",
+						},
+						tgNetworkType: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The network type of the connection, such as vpc, classic or directlink",
+						},
+						tgDefaultPrefixFilter: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"permit", "deny"}),
+							Description:  "The action applied to the routes that match none of the prefix filters of the connection",
+						},
+						tgSimulationPrefixes: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The prefixes advertised by the network of the connection, such as the address prefixes of a VPC",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateCIDR,
+							},
+						},
+						tgSimulationReplacePrefixFilters: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the prefix filters replace the prefix filters of the existing connection instead of being added after them",
+						},
+						tgPrefixFilters: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The planned prefix filters of the connection, in evaluation order",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									tgAction: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"permit", "deny"}),
+										Description:  "Whether to permit or deny the prefix filter",
+									},
+									tgPrefix: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.ValidateCIDR,
+										Description:  "IP Prefix",
+									},
+									tgGe: {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "IP Prefix GE",
+									},
+									tgLe: {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "IP Prefix LE",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			tgSimulationRouteReportId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the route report the prefixes of the existing connections were taken from",
+			},
+			tgSimulationSimulatedConnections: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The connections of the simulation with their expected learned routes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgConnName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgNetworkType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgSimulationPlanned: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the connection is a planned connection",
+						},
+						tgDefaultPrefixFilter: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgSimulationPrefixes: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The prefixes advertised by the connection",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						tgSimulationLearnedRoutes: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The prefixes advertised by the other connections, with the result of the prefix filters of the connection",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									tgPrefix: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									tgSimulationSourceConnectionId: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									tgSimulationSourceConnectionName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									tgSimulationPermitted: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the connection learns the route",
+									},
+									tgSimulationFilterIndex: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The position of the prefix filter that matched the route, or -1 when the default prefix filter was applied",
+									},
+									tgPrefixFilterId: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The identifier of the existing prefix filter that matched the route",
+									},
+									tgAction: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			tgSimulationOverlappingPrefixes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The prefixes of different connections that overlap",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgConnectionId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgNetworkType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgSimulationOverlappingPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgSimulationOverlappingConnection: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						tgSimulationOverlappingNetworkType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type tgSimulatedFilter struct {
+	id     string
+	action string
+	prefix *net.IPNet
+	ge     int
+	le     int
+}
+
+type tgSimulatedConnection struct {
+	id            string
+	name          string
+	networkType   string
+	planned       bool
+	defaultAction string
+	prefixes      []*net.IPNet
+	filters       []tgSimulatedFilter
+}
+
+// key identifies the connection in the simulation. Planned connections have no identifier yet.
+func (c *tgSimulatedConnection) key() string {
+	if c.id != "" {
+		return c.id
+	}
+	return c.name
+}
+
+func dataSourceIBMTransitGatewayRouteSimulationRead(d *schema.ResourceData, meta interface{}) error {
+	connections := make([]*tgSimulatedConnection, 0)
+	byID := map[string]*tgSimulatedConnection{}
+
+	gatewayId := d.Get(tgGatewayId).(string)
+	if gatewayId != "" {
+		client, err := transitgatewayClient(meta)
+		if err != nil {
+			return err
+		}
+		live, err := listTransitGatewayRouteSimulationConnections(client, gatewayId)
+		if err != nil {
+			return err
+		}
+		for _, c := range live {
+			connections = append(connections, c)
+			byID[c.id] = c
+		}
+		if d.Get(tgSimulationUseRouteReport).(bool) {
+			reportID, err := loadTransitGatewayRouteSimulationReport(client, gatewayId, byID)
+			if err != nil {
+				return err
+			}
+			d.Set(tgSimulationRouteReportId, reportID)
+		}
+	}
+
+	for i, v := range d.Get(tgSimulationConnections).([]interface{}) {
+		input := v.(map[string]interface{})
+		id := input[ID].(string)
+		connection, exists := byID[id]
+		if id != "" && !exists {
+			return flex.FmtErrorf("[ERROR] The connection %s of the route simulation is not a connection of the transit gateway %s", id, gatewayId)
+		}
+		if !exists {
+			connection = &tgSimulatedConnection{
+				name:          input[tgConnName].(string),
+				planned:       true,
+				defaultAction: tgSimulationDefaultFilterAction,
+			}
+			if connection.name == "" {
+				connection.name = fmt.Sprintf("planned-%d", i)
+			}
+			connections = append(connections, connection)
+		}
+		if name := input[tgConnName].(string); name != "" {
+			connection.name = name
+		}
+		if networkType := input[tgNetworkType].(string); networkType != "" {
+			connection.networkType = networkType
+		}
+		if action := input[tgDefaultPrefixFilter].(string); action != "" {
+			connection.defaultAction = action
+		}
+		if prefixes := input[tgSimulationPrefixes].([]interface{}); len(prefixes) > 0 {
+			connection.prefixes = nil
+			for _, p := range prefixes {
+				_, prefix, err := net.ParseCIDR(p.(string))
+				if err != nil {
+					return flex.FmtErrorf("[ERROR] Invalid prefix %s of connection %s: %s", p, connection.key(), err)
+				}
+				connection.prefixes = append(connection.prefixes, prefix)
+			}
+		}
+		if input[tgSimulationReplacePrefixFilters].(bool) {
+			connection.filters = nil
+		}
+		for _, f := range input[tgPrefixFilters].([]interface{}) {
+			filter := f.(map[string]interface{})
+			_, prefix, err := net.ParseCIDR(filter[tgPrefix].(string))
+			if err != nil {
+				return flex.FmtErrorf("[ERROR] Invalid prefix filter %s of connection %s: %s", filter[tgPrefix], connection.key(), err)
+			}
+			connection.filters = append(connection.filters, tgSimulatedFilter{
+				action: filter[tgAction].(string),
+				prefix: prefix,
+				ge:     filter[tgGe].(int),
+				le:     filter[tgLe].(int),
+			})
+		}
+	}
+
+	simulated := make([]map[string]interface{}, 0, len(connections))
+	for _, connection := range connections {
+		prefixes := make([]string, 0, len(connection.prefixes))
+		for _, prefix := range connection.prefixes {
+			prefixes = append(prefixes, prefix.String())
+		}
+		learned := make([]map[string]interface{}, 0)
+		for _, source := range connections {
+			if source == connection {
+				continue
+			}
+			for _, prefix := range source.prefixes {
+				action, index := evaluateTransitGatewayPrefixFilters(connection.filters, connection.defaultAction, prefix)
+				route := map[string]interface{}{
+					tgPrefix:                         prefix.String(),
+					tgSimulationSourceConnectionId:   source.id,
+					tgSimulationSourceConnectionName: source.name,
+					tgSimulationPermitted:            action == "permit",
+					tgSimulationFilterIndex:          index,
+					tgAction:                         action,
+				}
+				if index >= 0 {
+					route[tgPrefixFilterId] = connection.filters[index].id
+				}
+				learned = append(learned, route)
+			}
+		}
+		simulated = append(simulated, map[string]interface{}{
+			ID:                        connection.id,
+			tgConnName:                connection.name,
+			tgNetworkType:             connection.networkType,
+			tgSimulationPlanned:       connection.planned,
+			tgDefaultPrefixFilter:     connection.defaultAction,
+			tgSimulationPrefixes:      prefixes,
+			tgSimulationLearnedRoutes: learned,
+		})
+	}
+
+	overlaps := make([]map[string]interface{}, 0)
+	for i, connection := range connections {
+		for _, other := range connections[i+1:] {
+			for _, prefix := range connection.prefixes {
+				for _, otherPrefix := range other.prefixes {
+					if !transitGatewayPrefixesOverlap(prefix, otherPrefix) {
+						continue
+					}
+					overlaps = append(overlaps, map[string]interface{}{
+						tgPrefix:                           prefix.String(),
+						tgConnectionId:                     connection.key(),
+						tgNetworkType:                      connection.networkType,
+						tgSimulationOverlappingPrefix:      otherPrefix.String(),
+						tgSimulationOverlappingConnection:  other.key(),
+						tgSimulationOverlappingNetworkType: other.networkType,
+					})
+				}
+			}
+		}
+	}
+
+	if gatewayId != "" {
+		d.SetId(gatewayId)
+	} else {
+		d.SetId(time.Now().UTC().String())
+	}
+	d.Set(tgSimulationSimulatedConnections, simulated)
+	d.Set(tgSimulationOverlappingPrefixes, overlaps)
+	return nil
+}
+
+// listTransitGatewayRouteSimulationConnections lists the connections of a gateway with their prefix
+// filters, in evaluation order.
+func listTransitGatewayRouteSimulationConnections(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId string) ([]*tgSimulatedConnection, error) {
+	connections := make([]*tgSimulatedConnection, 0)
+	startSub := ""
+	listOptions := &transitgatewayapisv1.ListTransitGatewayConnectionsOptions{}
+	listOptions.SetTransitGatewayID(gatewayId)
+	for {
+		if startSub != "" {
+			listOptions.Start = &startSub
+		}
+		result, response, err := client.ListTransitGatewayConnections(listOptions)
+		if err != nil {
+			return nil, flex.FmtErrorf("Error while listing transit gateway connections %s\n%s", err, response)
+		}
+		for _, c := range result.Connections {
+			connection := &tgSimulatedConnection{
+				id:            *c.ID,
+				defaultAction: tgSimulationDefaultFilterAction,
+			}
+			if c.Name != nil {
+				connection.name = *c.Name
+			}
+			if c.NetworkType != nil {
+				connection.networkType = *c.NetworkType
+			}
+			if c.PrefixFiltersDefault != nil {
+				connection.defaultAction = *c.PrefixFiltersDefault
+			}
+			connections = append(connections, connection)
+		}
+		startSub = flex.GetNext(result.Next)
+		if startSub == "" {
+			break
+		}
+	}
+
+	for _, connection := range connections {
+		filterOptions := &transitgatewayapisv1.ListTransitGatewayConnectionPrefixFiltersOptions{}
+		filterOptions.SetTransitGatewayID(gatewayId)
+		filterOptions.SetID(connection.id)
+		result, response, err := client.ListTransitGatewayConnectionPrefixFilters(filterOptions)
+		if err != nil {
+			return nil, flex.FmtErrorf("Error while listing prefix filters of transit gateway connection %s %s\n%s", connection.id, err, response)
+		}
+		for _, f := range orderTransitGatewayPrefixFilters(result.PrefixFilters) {
+			_, prefix, err := net.ParseCIDR(*f.Prefix)
+			if err != nil {
+				return nil, flex.FmtErrorf("Error while parsing prefix filter %s of transit gateway connection %s %s", *f.ID, connection.id, err)
+			}
+			filter := tgSimulatedFilter{id: *f.ID, action: *f.Action, prefix: prefix}
+			if f.Ge != nil {
+				filter.ge = int(*f.Ge)
+			}
+			if f.Le != nil {
+				filter.le = int(*f.Le)
+			}
+			connection.filters = append(connection.filters, filter)
+		}
+	}
+	return connections, nil
+}
+
+// orderTransitGatewayPrefixFilters orders the prefix filters of a connection by their before
+// references. A filter without a before reference is evaluated last.
+func orderTransitGatewayPrefixFilters(filters []transitgatewayapisv1.PrefixFilterCust) []transitgatewayapisv1.PrefixFilterCust {
+	byBefore := map[string]transitgatewayapisv1.PrefixFilterCust{}
+	for _, f := range filters {
+		before := ""
+		if f.Before != nil {
+			before = *f.Before
+		}
+		byBefore[before] = f
+	}
+	ordered := make([]transitgatewayapisv1.PrefixFilterCust, 0, len(filters))
+	next := ""
+	for range filters {
+		f, ok := byBefore[next]
+		if !ok {
+			break
+		}
+		ordered = append(ordered, f)
+		next = *f.ID
+	}
+	if len(ordered) != len(filters) {
+		// The references do not form a chain, keep the order of the API.
+		return filters
+	}
+	for i, j := 0, len(ordered)-1; i < j; i, j = i+1, j-1 {
+		ordered[i], ordered[j] = ordered[j], ordered[i]
+	}
+	return ordered
+}
+
+// loadTransitGatewayRouteSimulationReport sets the prefixes of the connections from the most recent
+// complete route report of the gateway, and returns the identifier of the report.
+func loadTransitGatewayRouteSimulationReport(client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId string, connections map[string]*tgSimulatedConnection) (string, error) {
+	listOptions := &transitgatewayapisv1.ListTransitGatewayRouteReportsOptions{}
+	listOptions.SetTransitGatewayID(gatewayId)
+	result, response, err := client.ListTransitGatewayRouteReports(listOptions)
+	if err != nil {
+		return "", flex.FmtErrorf("Error while listing transit gateway route reports %s\n%s", err, response)
+	}
+	reports := make([]transitgatewayapisv1.RouteReport, 0)
+	for _, report := range result.RouteReports {
+		if report.Status != nil && *report.Status == transitgatewayapisv1.RouteReport_Status_Complete && report.CreatedAt != nil {
+			reports = append(reports, report)
+		}
+	}
+	if len(reports) == 0 {
+		return "", nil
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return time.Time(*reports[i].CreatedAt).After(time.Time(*reports[j].CreatedAt))
+	})
+	for _, reportConnection := range reports[0].Connections {
+		if reportConnection.ID == nil {
+			continue
+		}
+		connection, ok := connections[*reportConnection.ID]
+		if !ok {
+			continue
+		}
+		for _, route := range reportConnection.Routes {
+			if route.Prefix == nil {
+				continue
+			}
+			_, prefix, err := net.ParseCIDR(*route.Prefix)
+			if err != nil {
+				continue
+			}
+			connection.prefixes = append(connection.prefixes, prefix)
+		}
+	}
+	return *reports[0].ID, nil
+}
+
+// evaluateTransitGatewayPrefixFilters returns the action applied to a route by the prefix filters of
+// a connection, and the position of the filter that matched, or -1 when no filter matched.
+func evaluateTransitGatewayPrefixFilters(filters []tgSimulatedFilter, defaultAction string, route *net.IPNet) (string, int) {
+	for i, filter := range filters {
+		if transitGatewayPrefixFilterMatches(filter, route) {
+			return filter.action, i
+		}
+	}
+	return defaultAction, -1
+}
+
+// transitGatewayPrefixFilterMatches reports whether a route matches a prefix filter. Without ge and
+// le, the route must be the prefix of the filter. Otherwise the route must be in the prefix of the
+// filter and its length must be between ge and le.
+func transitGatewayPrefixFilterMatches(filter tgSimulatedFilter, route *net.IPNet) bool {
+	filterLength, bits := filter.prefix.Mask.Size()
+	routeLength, routeBits := route.Mask.Size()
+	if bits != routeBits || routeLength < filterLength || !filter.prefix.Contains(route.IP) {
+		return false
+	}
+	if filter.ge == 0 && filter.le == 0 {
+		return routeLength == filterLength
+	}
+	minLength, maxLength := filterLength, bits
+	if filter.ge > 0 {
+		minLength = filter.ge
+	}
+	if filter.le > 0 {
+		maxLength = filter.le
+	}
+	return routeLength >= minLength && routeLength <= maxLength
+}
+
+// transitGatewayPrefixesOverlap reports whether two prefixes share addresses.
+func transitGatewayPrefixesOverlap(a, b *net.IPNet) bool {
+	if (a.IP.To4() == nil) != (b.IP.To4() == nil) {
+		return false
+	}
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
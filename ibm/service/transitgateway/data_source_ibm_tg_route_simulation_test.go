@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMTransitGatewayRouteSimulationDataSource_planned(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayRouteSimulationDataSourcePlannedConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "simulated_connections.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "simulated_connections.0.learned_routes.1.permitted", "false"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "simulated_connections.0.learned_routes.1.filter_index", "0"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "simulated_connections.1.learned_routes.0.permitted", "true"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "overlapping_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "overlapping_prefixes.0.overlapping_prefix", "10.0.4.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMTransitGatewayRouteSimulationDataSource_gateway(t *testing.T) {
+	gatewayname := fmt.Sprintf("gateway-name-%d", acctest.RandIntRange(10, 100))
+	location := fmt.Sprintf("us-south")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayRouteSimulationDataSourceGatewayConfig(gatewayname, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "simulated_connections.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_tg_route_simulation.test_tg_route_simulation", "simulated_connections.0.planned", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayRouteSimulationDataSourcePlannedConfig() string {
+	return `
+	data "ibm_tg_route_simulation" "test_tg_route_simulation" {
+		connections {
+			name         = "vpc"
+			network_type = "vpc"
+			prefixes     = ["10.0.0.0/16", "10.1.0.0/24"]
+			prefix_filters {
+				action = "deny"
+				prefix = "192.168.0.0/16"
+				le     = 24
+			}
+		}
+		connections {
+			name                  = "directlink"
+			network_type          = "directlink"
+			default_prefix_filter = "deny"
+			prefixes              = ["10.0.4.0/24", "192.168.1.0/24"]
+			prefix_filters {
+				action = "permit"
+				prefix = "10.0.0.0/8"
+				ge     = 16
+				le     = 24
+			}
+		}
+	}
+	`
+}
+
+func testAccCheckIBMTransitGatewayRouteSimulationDataSourceGatewayConfig(gatewayname, location string) string {
+	return fmt.Sprintf(`
+	resource "ibm_tg_gateway" "test_tg_gateway" {
+		name     = "%s"
+		location = "%s"
+		global   = true
+	}
+
+	data "ibm_tg_route_simulation" "test_tg_route_simulation" {
+		gateway = ibm_tg_gateway.test_tg_gateway.id
+		connections {
+			name         = "classic"
+			network_type = "classic"
+			prefixes     = ["10.10.0.0/16"]
+		}
+	}
+	`, gatewayname, location)
+}
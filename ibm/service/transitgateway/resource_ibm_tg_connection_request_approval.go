@@ -0,0 +1,356 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	tgRequestApprovalNamePattern    = "name_pattern"
+	tgRequestApprovalWaitForRequest = "wait_for_request"
+	tgRequestApprovalPending        = "pending_requests"
+	tgRequestApprovalProcessed      = "processed_connections"
+	tgRequestApprovalWaiting        = "waiting"
+	tgRequestApprovalFound          = "found"
+)
+
+var tgRequestApprovalFilters = []string{tgNetworkId, tgNetworkAccountID, tgRequestApprovalNamePattern}
+
+func ResourceIBMTransitGatewayConnectionRequestApproval() *schema.Resource {
+	connectionSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			tgConnectionId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Transit Gateway Connection identifier",
+			},
+			tgConnName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user-defined name for this transit gateway connection",
+			},
+			tgNetworkType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Defines what type of network is connected via this connection",
+			},
+			tgNetworkId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the network being connected via this connection",
+			},
+			tgNetworkAccountID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account which owns the network",
+			},
+			tgRequestStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The request status of the connection",
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceIBMTransitGatewayConnectionRequestApprovalCreate,
+		Read:   resourceIBMTransitGatewayConnectionRequestApprovalRead,
+		Update: resourceIBMTransitGatewayConnectionRequestApprovalUpdate,
+		Delete: resourceIBMTransitGatewayConnectionRequestApprovalDelete,
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			// The requesting account chooses the connection name, so the name alone cannot identify
+			// the requests to approve
+			if diff.Get(tgConnectionAction).(string) == "approve" &&
+				diff.NewValueKnown(tgNetworkId) && diff.Get(tgNetworkId).(string) == "" &&
+				diff.NewValueKnown(tgNetworkAccountID) && diff.Get(tgNetworkAccountID).(string) == "" {
+				return fmt.Errorf("%s or %s must be set to approve connection requests", tgNetworkId, tgNetworkAccountID)
+			}
+			// Pending requests found during refresh are processed by the next apply
+			if diff.Id() != "" && len(diff.Get(tgRequestApprovalPending).([]interface{})) > 0 {
+				return diff.SetNewComputed(tgRequestApprovalPending)
+			}
+			return nil
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			tgGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit Gateway identifier",
+			},
+			tgConnectionAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_connection_request_approval", tgConnectionAction),
+				Description:  "The action to perform on the matching cross account connection requests",
+			},
+			tgNetworkId: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: tgRequestApprovalFilters,
+				Description:  "Only process the requests to connect the network with this ID",
+			},
+			tgNetworkAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: tgRequestApprovalFilters,
+				Description:  "Only process the requests to connect a network of this account",
+			},
+			tgRequestApprovalNamePattern: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: tgRequestApprovalFilters,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only process the requests whose connection name matches this regular expression",
+			},
+			tgNetworkType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_connection_request_approval", tgNetworkType),
+				Description:  "Only process the requests to connect a network of this type",
+			},
+			tgRequestApprovalWaitForRequest: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until at least one matching request is pending when the resource is created",
+			},
+			tgRequestApprovalPending: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching connection requests that are pending and are processed by the next apply",
+				Elem:        connectionSchema,
+			},
+			tgRequestApprovalProcessed: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The connection requests processed by this resource",
+				Elem:        connectionSchema,
+			},
+		},
+	}
+}
+
+func ResourceIBMTransitGatewayConnectionRequestApprovalValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tgConnectionAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "approve, reject"},
+		validate.ValidateSchema{
+			Identifier:                 tgNetworkType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "classic, directlink, gre_tunnel, power_virtual_server, redundant_gre, unbound_gre_tunnel, vpc, vpn_gateway"})
+
+	ibmTransitGatewayConnectionRequestApprovalResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection_request_approval", Schema: validateSchema}
+
+	return &ibmTransitGatewayConnectionRequestApprovalResourceValidator
+}
+
+func resourceIBMTransitGatewayConnectionRequestApprovalCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	gatewayId := d.Get(tgGatewayId).(string)
+
+	if d.Get(tgRequestApprovalWaitForRequest).(bool) {
+		if err := isWaitForTransitGatewayConnectionRequest(client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return flex.FmtErrorf("Error waiting for a connection request to Transit Gateway (%s): %s", gatewayId, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", gatewayId, d.Get(tgConnectionAction).(string)))
+	d.Set(tgRequestApprovalProcessed, []map[string]interface{}{})
+	if err := processTransitGatewayConnectionRequests(client, d); err != nil {
+		return err
+	}
+	return resourceIBMTransitGatewayConnectionRequestApprovalRead(d, meta)
+}
+
+func resourceIBMTransitGatewayConnectionRequestApprovalRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+
+	pending, response, err := listTransitGatewayConnectionRequests(client, d)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	pendingRequests := make([]map[string]interface{}, 0, len(pending))
+	for _, connection := range pending {
+		pendingRequests = append(pendingRequests, flattenTransitGatewayConnectionRequest(connection))
+	}
+	d.Set(tgRequestApprovalPending, pendingRequests)
+	return nil
+}
+
+func resourceIBMTransitGatewayConnectionRequestApprovalUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return err
+	}
+	if err := processTransitGatewayConnectionRequests(client, d); err != nil {
+		return err
+	}
+	return resourceIBMTransitGatewayConnectionRequestApprovalRead(d, meta)
+}
+
+func resourceIBMTransitGatewayConnectionRequestApprovalDelete(d *schema.ResourceData, meta interface{}) error {
+	// Approved and rejected requests cannot be reverted
+	d.SetId("")
+	return nil
+}
+
+// processTransitGatewayConnectionRequests performs the action on the pending connection requests that
+// match the filters, and adds them to the processed connections.
+func processTransitGatewayConnectionRequests(client *transitgatewayapisv1.TransitGatewayApisV1, d *schema.ResourceData) error {
+	gatewayId := d.Get(tgGatewayId).(string)
+	action := d.Get(tgConnectionAction).(string)
+
+	pending, _, err := listTransitGatewayConnectionRequests(client, d)
+	if err != nil {
+		return err
+	}
+	processed := d.Get(tgRequestApprovalProcessed).([]interface{})
+	for _, connection := range pending {
+		createTransitGatewayConnectionActionsOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions{}
+		createTransitGatewayConnectionActionsOptions.SetTransitGatewayID(gatewayId)
+		createTransitGatewayConnectionActionsOptions.SetID(*connection.ID)
+		createTransitGatewayConnectionActionsOptions.SetAction(action)
+		response, err := client.CreateTransitGatewayConnectionActions(createTransitGatewayConnectionActionsOptions)
+		if err != nil {
+			d.Set(tgRequestApprovalProcessed, processed)
+			return flex.FmtErrorf("Error performing the %s action on the Transit Gateway Connection (%s): %s\n%s", action, *connection.ID, err, response)
+		}
+		log.Printf("[INFO] Performed the %s action on the Transit Gateway Connection (%s) named %s", action, *connection.ID, *connection.Name)
+
+		processedConnection := flattenTransitGatewayConnectionRequest(connection)
+		if action == "approve" {
+			processedConnection[tgRequestStatus] = transitgatewayapisv1.TransitGatewayConnectionCust_RequestStatus_Approved
+		} else {
+			processedConnection[tgRequestStatus] = transitgatewayapisv1.TransitGatewayConnectionCust_RequestStatus_Rejected
+		}
+		processed = append(processed, processedConnection)
+	}
+	d.Set(tgRequestApprovalProcessed, processed)
+	return nil
+}
+
+// listTransitGatewayConnectionRequests returns the pending cross account connection requests of the
+// transit gateway that match the filters.
+func listTransitGatewayConnectionRequests(client *transitgatewayapisv1.TransitGatewayApisV1, d *schema.ResourceData) ([]transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+	gatewayId := d.Get(tgGatewayId).(string)
+	networkId := d.Get(tgNetworkId).(string)
+	networkAccountId := d.Get(tgNetworkAccountID).(string)
+	networkType := d.Get(tgNetworkType).(string)
+	var namePattern *regexp.Regexp
+	if pattern := d.Get(tgRequestApprovalNamePattern).(string); pattern != "" {
+		namePattern = regexp.MustCompile(pattern)
+	}
+
+	pending := []transitgatewayapisv1.TransitGatewayConnectionCust{}
+	startSub := ""
+	for {
+		listTransitGatewayConnectionsOptions := &transitgatewayapisv1.ListTransitGatewayConnectionsOptions{}
+		listTransitGatewayConnectionsOptions.SetTransitGatewayID(gatewayId)
+		if startSub != "" {
+			listTransitGatewayConnectionsOptions.Start = &startSub
+		}
+		listTGConnections, response, err := client.ListTransitGatewayConnections(listTransitGatewayConnectionsOptions)
+		if err != nil {
+			return nil, response, flex.FmtErrorf("Error while listing Transit Gateway Connections for gateway %s: %s\n%s", gatewayId, err, response)
+		}
+		for _, connection := range listTGConnections.Connections {
+			if connection.RequestStatus == nil || *connection.RequestStatus != transitgatewayapisv1.TransitGatewayConnectionCust_RequestStatus_Pending {
+				continue
+			}
+			if networkId != "" && flex.StringValue(connection.NetworkID) != networkId {
+				continue
+			}
+			if networkAccountId != "" && flex.StringValue(connection.NetworkAccountID) != networkAccountId {
+				continue
+			}
+			if networkType != "" && flex.StringValue(connection.NetworkType) != networkType {
+				continue
+			}
+			if namePattern != nil && !namePattern.MatchString(flex.StringValue(connection.Name)) {
+				continue
+			}
+			pending = append(pending, connection)
+		}
+		startSub = flex.GetNext(listTGConnections.Next)
+		if startSub == "" {
+			break
+		}
+	}
+	return pending, nil, nil
+}
+
+func isWaitForTransitGatewayConnectionRequest(client *transitgatewayapisv1.TransitGatewayApisV1, d *schema.ResourceData, timeout time.Duration) error {
+	log.Printf("Waiting for a connection request to transit gateway (%s).", d.Get(tgGatewayId).(string))
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tgRequestApprovalWaiting},
+		Target:  []string{tgRequestApprovalFound},
+		Refresh: func() (interface{}, string, error) {
+			pending, _, err := listTransitGatewayConnectionRequests(client, d)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(pending) > 0 {
+				return pending, tgRequestApprovalFound, nil
+			}
+			return pending, tgRequestApprovalWaiting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func flattenTransitGatewayConnectionRequest(connection transitgatewayapisv1.TransitGatewayConnectionCust) map[string]interface{} {
+	return map[string]interface{}{
+		tgConnectionId:     flex.StringValue(connection.ID),
+		tgConnName:         flex.StringValue(connection.Name),
+		tgNetworkType:      flex.StringValue(connection.NetworkType),
+		tgNetworkId:        flex.StringValue(connection.NetworkID),
+		tgNetworkAccountID: flex.StringValue(connection.NetworkAccountID),
+		tgRequestStatus:    flex.StringValue(connection.RequestStatus),
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMTransitGatewayConnectionRequestApproval_basic(t *testing.T) {
+	var randNum = acctest.RandIntRange(10, 100)
+	connectionName := fmt.Sprintf("tg-connection-name-%d", randNum)
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", randNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// The connection name is chosen by the requesting account, so it cannot be the only filter
+				Config:      testAccCheckIBMTransitGatewayConnectionRequestApprovalConfig(gatewayName, connectionName, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be set to approve connection requests"),
+			},
+			{
+				Config: testAccCheckIBMTransitGatewayConnectionRequestApprovalConfig(gatewayName, connectionName, acc.Tg_cross_network_account_id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_connection_request_approval.test_tg_xac_approval", "processed_connections.#", "1"),
+					resource.TestCheckResourceAttr("ibm_tg_connection_request_approval.test_tg_xac_approval", "processed_connections.0.name", connectionName),
+					resource.TestCheckResourceAttr("ibm_tg_connection_request_approval.test_tg_xac_approval", "processed_connections.0.request_status", "approved"),
+					resource.TestCheckResourceAttr("ibm_tg_connection_request_approval.test_tg_xac_approval", "pending_requests.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayConnectionRequestApprovalConfig(gatewayName, connectionName, approvalAccountId string) string {
+	return fmt.Sprintf(`
+resource "ibm_tg_gateway" "test_tg_gateway"{
+	provider = ibm
+	name="%s"
+	location="us-south"
+	global=true
+}
+
+resource "ibm_tg_connection" "test_tg_xac_connection" {
+	provider = ibm
+	gateway = ibm_tg_gateway.test_tg_gateway.id
+	network_type = "classic"
+	name = "%s"
+	network_account_id = "%s"
+}
+
+resource "ibm_tg_connection_request_approval" "test_tg_xac_approval" {
+	provider = ibm.account2
+	depends_on = [ibm_tg_connection.test_tg_xac_connection]
+	gateway = ibm_tg_gateway.test_tg_gateway.id
+	action = "approve"
+	network_account_id = "%s"
+	network_type = "classic"
+	name_pattern = "^tg-connection-name-"
+	wait_for_request = true
+}
+	  `, gatewayName, connectionName, acc.Tg_cross_network_account_id, approvalAccountId)
+}